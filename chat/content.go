@@ -0,0 +1,148 @@
+package chat
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ScoreContent is the content of a score component
+type ScoreContent struct {
+	Name      string `json:"name"`            // Score holder or selector
+	Objective string `json:"objective"`       // Objective name
+	Value     string `json:"value,omitempty"` // Resolved value (older servers)
+}
+
+// UnmarshalJSON accepts a component object, a bare string or number (plain
+// text), or an array (the first element with the rest appended as extras)
+func (c *Component) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil
+	}
+	switch data[0] {
+	case '"':
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		*c = Component{Text: text}
+		return nil
+	case '[':
+		var list []Component
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		if len(list) == 0 {
+			*c = Component{}
+			return nil
+		}
+		*c = list[0]
+		c.Extra = append(c.Extra, list[1:]...)
+		return nil
+	case '{':
+		type component Component // Drops the UnmarshalJSON method
+		var decoded component
+		if err := json.Unmarshal(data, &decoded); err != nil {
+			return err
+		}
+		*c = Component(decoded)
+		return nil
+	case 'n':
+		*c = Component{}
+		return nil
+	}
+	// Numbers and booleans, as used in translation arguments
+	*c = Component{Text: string(data)}
+	return nil
+}
+
+// contentPart is a piece of rendered content: literal text or a nested component
+type contentPart struct {
+	text string
+	arg  *Component
+}
+
+// contents renders the content of the component (without its children) with a
+// translator (nil for none). Score, selector and NBT components show what the
+// server resolved them to, or their raw form when unresolved. Keybinds show the
+// translated action name since the client key mapping is unknown.
+func (c *Component) contents(t *Translator) []contentPart {
+	switch {
+	case c.Translate != "":
+		format, ok := t.Translate(c.Translate)
+		if !ok {
+			format = c.Fallback
+		}
+		if format == "" {
+			format = c.Translate
+		}
+		return formatTranslation(format, c.With)
+	case c.Score != nil:
+		return []contentPart{{text: c.Score.Value}}
+	case c.Selector != "":
+		return []contentPart{{text: c.Selector}}
+	case c.Keybind != "":
+		if name, ok := t.Translate(c.Keybind); ok {
+			return []contentPart{{text: name}}
+		}
+		return []contentPart{{text: c.Keybind}}
+	case c.NBT != "":
+		return nil
+	}
+	return []contentPart{{text: c.Text}}
+}
+
+// formatTranslation substitutes %s, %n$s and %% in a translation format. A bad
+// specifier or missing argument renders the whole format literally, as vanilla does.
+func formatTranslation(format string, args []Component) []contentPart {
+	var parts []contentPart
+	var text strings.Builder
+	next := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			text.WriteByte(format[i])
+			continue
+		}
+		j := i + 1
+		for j < len(format) && format[j] >= '0' && format[j] <= '9' {
+			j++
+		}
+		index := next
+		if j > i+1 {
+			if j >= len(format) || format[j] != '$' {
+				return []contentPart{{text: format}}
+			}
+			n, err := strconv.Atoi(format[i+1 : j])
+			if err != nil || n < 1 {
+				return []contentPart{{text: format}}
+			}
+			index = n - 1
+			j++
+		}
+		switch {
+		case j < len(format) && format[j] == '%' && j == i+1:
+			text.WriteByte('%')
+		case j < len(format) && format[j] == 's':
+			if index >= len(args) {
+				return []contentPart{{text: format}}
+			}
+			if text.Len() > 0 {
+				parts = append(parts, contentPart{text: text.String()})
+				text.Reset()
+			}
+			parts = append(parts, contentPart{arg: &args[index]})
+			if j == i+1 {
+				next++
+			}
+		default:
+			return []contentPart{{text: format}}
+		}
+		i = j
+	}
+	if text.Len() > 0 {
+		parts = append(parts, contentPart{text: text.String()})
+	}
+	return parts
+}
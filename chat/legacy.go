@@ -0,0 +1,223 @@
+package chat
+
+import "strings"
+
+// Legacy formatting code prefixes
+const (
+	SectionSign = '§' // Used by the server and in logs
+	Ampersand   = '&' // Common alternate used in plugin configs
+)
+
+// legacyColors maps legacy color codes to color names
+var legacyColors = map[rune]string{
+	'0': ColorBlack, '1': ColorDarkBlue, '2': ColorDarkGreen, '3': ColorDarkAqua,
+	'4': ColorDarkRed, '5': ColorDarkPurple, '6': ColorGold, '7': ColorGray,
+	'8': ColorDarkGray, '9': ColorBlue, 'a': ColorGreen, 'b': ColorAqua,
+	'c': ColorRed, 'd': ColorLightPurple, 'e': ColorYellow, 'f': ColorWhite,
+}
+
+// legacyCodes maps color names back to legacy color codes
+var legacyCodes = func() map[string]rune {
+	codes := make(map[string]rune, len(legacyColors))
+	for code, color := range legacyColors {
+		codes[color] = code
+	}
+	return codes
+}()
+
+// legacyFormats lists the format codes in the order they are emitted
+var legacyFormats = []struct {
+	code rune
+	get  func(*Style) *bool
+}{
+	{'k', func(s *Style) *bool { return &s.Obfuscated }},
+	{'l', func(s *Style) *bool { return &s.Bold }},
+	{'m', func(s *Style) *bool { return &s.Strikethrough }},
+	{'n', func(s *Style) *bool { return &s.Underlined }},
+	{'o', func(s *Style) *bool { return &s.Italic }},
+}
+
+// ParseLegacy parses a string with legacy formatting codes introduced by prefix
+// (SectionSign or Ampersand). A color code resets the formats, r resets
+// everything and x followed by six digit codes sets a hex color. A prefix not
+// followed by a valid code is kept as text, so "Tom & Jerry" parses unchanged.
+func ParseLegacy(s string, prefix rune) *Message {
+	root := Component{}
+	var style Style
+	var text strings.Builder
+	flush := func() {
+		if text.Len() == 0 {
+			return
+		}
+		c := Component{Text: text.String()}
+		c.ApplyStyle(style)
+		root.Extra = append(root.Extra, c)
+		text.Reset()
+	}
+
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != prefix || i+1 >= len(runes) {
+			text.WriteRune(runes[i])
+			continue
+		}
+		code := toLowerCode(runes[i+1])
+		if color, ok := legacyColors[code]; ok {
+			flush()
+			style = Style{Color: color}
+			i++
+			continue
+		}
+		if code == 'x' {
+			if hex, ok := legacyHex(runes[i+2:], prefix); ok {
+				flush()
+				style = Style{Color: hex}
+				i += 13
+				continue
+			}
+		}
+		if code == 'r' {
+			flush()
+			style = Style{}
+			i++
+			continue
+		}
+		if format := legacyFormat(code); format != nil {
+			if !*format(&style) {
+				flush()
+				*format(&style) = true
+			}
+			i++
+			continue
+		}
+		// Not a code: keep the prefix as text and read the next rune normally
+		text.WriteRune(runes[i])
+	}
+	flush()
+
+	if len(root.Extra) == 1 {
+		root = root.Extra[0]
+	}
+	return &Message{Component: root}
+}
+
+// legacyFormat returns the style accessor for a format code, or nil
+func legacyFormat(code rune) func(*Style) *bool {
+	for _, f := range legacyFormats {
+		if f.code == code {
+			return f.get
+		}
+	}
+	return nil
+}
+
+// legacyHex reads the six prefixed digits of a §x hex color
+func legacyHex(runes []rune, prefix rune) (string, bool) {
+	if len(runes) < 12 {
+		return "", false
+	}
+	hex := make([]rune, 1, 7)
+	hex[0] = '#'
+	for i := 0; i < 12; i += 2 {
+		digit := toLowerCode(runes[i+1])
+		if runes[i] != prefix || !strings.ContainsRune("0123456789abcdef", digit) {
+			return "", false
+		}
+		hex = append(hex, digit)
+	}
+	return string(hex), true
+}
+
+// toLowerCode lowercases an ASCII code character
+func toLowerCode(r rune) rune {
+	if r >= 'A' && r <= 'Z' {
+		return r + 'a' - 'A'
+	}
+	return r
+}
+
+// ToLegacy flattens the message into a §-coded string, emitting the fewest codes
+// needed for each run of text and translating keys with t (nil for none). Colors
+// without a legacy code (other than hex) and click and hover events are dropped.
+func (m *Message) ToLegacy(t *Translator) string {
+	var sb strings.Builder
+	var current Style
+	writeLegacy(&sb, m.Component, Style{}, &current, t)
+	return sb.String()
+}
+
+// writeLegacy writes a component and its children with the inherited style
+func writeLegacy(sb *strings.Builder, c Component, parent Style, current *Style, t *Translator) {
+	style := parent
+	style.Bold = style.Bold || c.Bold
+	style.Italic = style.Italic || c.Italic
+	style.Underlined = style.Underlined || c.Underlined
+	style.Strikethrough = style.Strikethrough || c.Strikethrough
+	style.Obfuscated = style.Obfuscated || c.Obfuscated
+	if c.Color != "" {
+		style.Color = legacyColor(c.Color)
+	}
+
+	for _, part := range c.contents(t) {
+		if part.arg != nil {
+			writeLegacy(sb, *part.arg, style, current, t)
+		} else if part.text != "" {
+			writeLegacyStyle(sb, *current, style)
+			*current = style
+			sb.WriteString(part.text)
+		}
+	}
+	for _, extra := range c.Extra {
+		writeLegacy(sb, extra, style, current, t)
+	}
+}
+
+// legacyColor normalizes a color to one that legacy codes can express ("" if none)
+func legacyColor(color string) string {
+	color = strings.ToLower(color)
+	if _, ok := legacyCodes[color]; ok {
+		return color
+	}
+	if len(color) == 7 && color[0] == '#' && strings.Trim(color[1:], "0123456789abcdef") == "" {
+		return color
+	}
+	return ""
+}
+
+// writeLegacyStyle writes the codes that change the from style into the to style
+func writeLegacyStyle(sb *strings.Builder, from, to Style) {
+	if from == to {
+		return
+	}
+	// Codes can only add formats; a color or reset code is needed to remove one
+	removes := from.Color != to.Color
+	for _, f := range legacyFormats {
+		if *f.get(&from) && !*f.get(&to) {
+			removes = true
+		}
+	}
+	if removes {
+		from = Style{}
+		if to.Color == "" {
+			writeLegacyCode(sb, 'r')
+		} else if code, ok := legacyCodes[to.Color]; ok {
+			writeLegacyCode(sb, code)
+		} else {
+			writeLegacyCode(sb, 'x')
+			for _, digit := range to.Color[1:] {
+				writeLegacyCode(sb, digit)
+			}
+		}
+	}
+	for _, f := range legacyFormats {
+		if *f.get(&to) && !*f.get(&from) {
+			writeLegacyCode(sb, f.code)
+		}
+	}
+}
+
+// writeLegacyCode writes a single § code
+func writeLegacyCode(sb *strings.Builder, code rune) {
+	sb.WriteRune(SectionSign)
+	sb.WriteRune(code)
+}
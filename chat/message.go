@@ -7,16 +7,30 @@ import (
 
 // Component represents a chat component (matches Minecraft's JSON chat format)
 type Component struct {
-	Text          string      `json:"text,omitempty"`
-	Bold          bool        `json:"bold,omitempty"`
-	Italic        bool        `json:"italic,omitempty"`
-	Underlined    bool        `json:"underlined,omitempty"`
-	Strikethrough bool        `json:"strikethrough,omitempty"`
-	Obfuscated    bool        `json:"obfuscated,omitempty"`
-	Color         string      `json:"color,omitempty"`
-	ClickEvent    *ClickEvent `json:"clickEvent,omitempty"`
-	HoverEvent    *HoverEvent `json:"hoverEvent,omitempty"`
-	Extra         []Component `json:"extra,omitempty"`
+	Type          string        `json:"type,omitempty"` // Content type hint ("text", "translatable", ...), optional
+	Text          string        `json:"text,omitempty"`
+	Translate     string        `json:"translate,omitempty"` // Translation key
+	Fallback      string        `json:"fallback,omitempty"`  // Used when the key is unknown
+	With          []Component   `json:"with,omitempty"`      // Translation arguments
+	Score         *ScoreContent `json:"score,omitempty"`     // Scoreboard value
+	Selector      string        `json:"selector,omitempty"`  // Entity selector (resolved by the server)
+	Separator     *Component    `json:"separator,omitempty"` // Between selector or NBT matches
+	Keybind       string        `json:"keybind,omitempty"`   // Key binding ID, e.g. "key.jump"
+	NBT           string        `json:"nbt,omitempty"`       // NBT path
+	Interpret     bool          `json:"interpret,omitempty"` // Parse NBT values as components
+	Block         string        `json:"block,omitempty"`     // NBT source block coordinates
+	Entity        string        `json:"entity,omitempty"`    // NBT source entity selector
+	Storage       string        `json:"storage,omitempty"`   // NBT source storage ID
+	Source        string        `json:"source,omitempty"`    // NBT source type hint
+	Bold          bool          `json:"bold,omitempty"`
+	Italic        bool          `json:"italic,omitempty"`
+	Underlined    bool          `json:"underlined,omitempty"`
+	Strikethrough bool          `json:"strikethrough,omitempty"`
+	Obfuscated    bool          `json:"obfuscated,omitempty"`
+	Color         string        `json:"color,omitempty"`
+	ClickEvent    *ClickEvent   `json:"clickEvent,omitempty"`
+	HoverEvent    *HoverEvent   `json:"hoverEvent,omitempty"`
+	Extra         []Component   `json:"extra,omitempty"`
 }
 
 // ClickEvent represents a click event on a chat component
@@ -43,16 +57,23 @@ func NewMessage(text string) *Message {
 	}
 }
 
-// ToPlainText converts the message to plain text (removes formatting)
-func (m *Message) ToPlainText() string {
-	return componentToPlainText(m.Component)
+// ToPlainText converts the message to plain text (removes formatting), translating
+// keys with t (nil leaves them as their fallback or raw key)
+func (m *Message) ToPlainText(t *Translator) string {
+	return componentToPlainText(m.Component, t)
 }
 
-func componentToPlainText(c Component) string {
+func componentToPlainText(c Component, t *Translator) string {
 	var sb strings.Builder
-	sb.WriteString(c.Text)
+	for _, part := range c.contents(t) {
+		if part.arg != nil {
+			sb.WriteString(componentToPlainText(*part.arg, t))
+		} else {
+			sb.WriteString(part.text)
+		}
+	}
 	for _, extra := range c.Extra {
-		sb.WriteString(componentToPlainText(extra))
+		sb.WriteString(componentToPlainText(extra, t))
 	}
 	return sb.String()
 }
@@ -0,0 +1,57 @@
+package chat
+
+import "encoding/json"
+
+// Translator looks up translation keys in a language file, falling back to
+// another language (usually en_us) for missing keys. A nil Translator knows no keys.
+type Translator struct {
+	Locale   string // Language code, e.g. "en_us"
+	entries  map[string]string
+	fallback *Translator
+}
+
+// NewTranslator creates a translator from key to format entries
+func NewTranslator(locale string, entries map[string]string, fallback *Translator) *Translator {
+	return &Translator{Locale: locale, entries: entries, fallback: fallback}
+}
+
+// ParseLanguage parses a vanilla language file (a flat JSON object of keys to formats)
+func ParseLanguage(locale string, data []byte, fallback *Translator) (*Translator, error) {
+	var entries map[string]string
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return NewTranslator(locale, entries, fallback), nil
+}
+
+// Translate returns the format for a key
+func (t *Translator) Translate(key string) (string, bool) {
+	for ; t != nil; t = t.fallback {
+		if format, ok := t.entries[key]; ok {
+			return format, true
+		}
+	}
+	return "", false
+}
+
+// Has checks if a key can be translated
+func (t *Translator) Has(key string) bool {
+	_, ok := t.Translate(key)
+	return ok
+}
+
+// Len returns the number of keys in this language (without the fallback)
+func (t *Translator) Len() int {
+	if t == nil {
+		return 0
+	}
+	return len(t.entries)
+}
+
+// Fallback returns the language used for missing keys
+func (t *Translator) Fallback() *Translator {
+	if t == nil {
+		return nil
+	}
+	return t.fallback
+}
@@ -3086,6 +3086,2477 @@ var HardnessMap = map[int]float64{
 	426:  1.0,   // zombie_wall_head
 }
 
+// RequiresToolMap stores the blocks that only drop when mined with a correct tool (Minecraft 1.21.10)
+var RequiresToolMap = map[int]bool{
+	938:  true, // amethyst_block
+	940:  true, // amethyst_cluster
+	876:  true, // ancient_debris
+	6:    true, // andesite
+	788:  true, // andesite_slab
+	775:  true, // andesite_stairs
+	800:  true, // andesite_wall
+	435:  true, // anvil
+	275:  true, // basalt
+	816:  true, // bell
+	693:  true, // black_concrete
+	677:  true, // black_glazed_terracotta
+	661:  true, // black_shulker_box
+	467:  true, // black_terracotta
+	884:  true, // blackstone
+	887:  true, // blackstone_slab
+	885:  true, // blackstone_stairs
+	886:  true, // blackstone_wall
+	809:  true, // blast_furnace
+	689:  true, // blue_concrete
+	673:  true, // blue_glazed_terracotta
+	657:  true, // blue_shulker_box
+	463:  true, // blue_terracotta
+	642:  true, // bone_block
+	722:  true, // brain_coral_block
+	355:  true, // brewing_stand
+	584:  true, // brick_slab
+	339:  true, // brick_stairs
+	792:  true, // brick_wall
+	175:  true, // bricks
+	690:  true, // brown_concrete
+	674:  true, // brown_glazed_terracotta
+	658:  true, // brown_shulker_box
+	464:  true, // brown_terracotta
+	723:  true, // bubble_coral_block
+	958:  true, // calcite
+	356:  true, // cauldron
+	327:  true, // chain
+	436:  true, // chipped_anvil
+	980:  true, // chiseled_copper
+	1077: true, // chiseled_deepslate
+	901:  true, // chiseled_nether_bricks
+	891:  true, // chiseled_polished_blackstone
+	447:  true, // chiseled_quartz_block
+	564:  true, // chiseled_red_sandstone
+	349:  true, // chiseled_resin_bricks
+	107:  true, // chiseled_sandstone
+	314:  true, // chiseled_stone_bricks
+	952:  true, // chiseled_tuff
+	957:  true, // chiseled_tuff_bricks
+	523:  true, // coal_block
+	46:   true, // coal_ore
+	1061: true, // cobbled_deepslate
+	1063: true, // cobbled_deepslate_slab
+	1062: true, // cobbled_deepslate_stairs
+	1064: true, // cobbled_deepslate_wall
+	12:   true, // cobblestone
+	583:  true, // cobblestone_slab
+	210:  true, // cobblestone_stairs
+	378:  true, // cobblestone_wall
+	129:  true, // cobweb
+	967:  true, // copper_block
+	1033: true, // copper_bulb
+	1009: true, // copper_door
+	1025: true, // copper_grate
+	971:  true, // copper_ore
+	1017: true, // copper_trapdoor
+	1078: true, // cracked_deepslate_bricks
+	1079: true, // cracked_deepslate_tiles
+	902:  true, // cracked_nether_bricks
+	890:  true, // cracked_polished_blackstone_bricks
+	313:  true, // cracked_stone_bricks
+	1093: true, // crafter
+	835:  true, // crimson_nylium
+	877:  true, // crying_obsidian
+	976:  true, // cut_copper
+	992:  true, // cut_copper_slab
+	988:  true, // cut_copper_stairs
+	565:  true, // cut_red_sandstone
+	590:  true, // cut_red_sandstone_slab
+	108:  true, // cut_sandstone
+	581:  true, // cut_sandstone_slab
+	687:  true, // cyan_concrete
+	671:  true, // cyan_glazed_terracotta
+	655:  true, // cyan_shulker_box
+	461:  true, // cyan_terracotta
+	437:  true, // damaged_anvil
+	497:  true, // dark_prismarine
+	503:  true, // dark_prismarine_slab
+	500:  true, // dark_prismarine_stairs
+	717:  true, // dead_brain_coral_block
+	718:  true, // dead_bubble_coral_block
+	719:  true, // dead_fire_coral_block
+	720:  true, // dead_horn_coral_block
+	716:  true, // dead_tube_coral_block
+	1060: true, // deepslate
+	1075: true, // deepslate_brick_slab
+	1074: true, // deepslate_brick_stairs
+	1076: true, // deepslate_brick_wall
+	1073: true, // deepslate_bricks
+	47:   true, // deepslate_coal_ore
+	972:  true, // deepslate_copper_ore
+	191:  true, // deepslate_diamond_ore
+	368:  true, // deepslate_emerald_ore
+	43:   true, // deepslate_gold_ore
+	45:   true, // deepslate_iron_ore
+	103:  true, // deepslate_lapis_ore
+	259:  true, // deepslate_redstone_ore
+	1071: true, // deepslate_tile_slab
+	1070: true, // deepslate_tile_stairs
+	1072: true, // deepslate_tile_wall
+	1069: true, // deepslate_tiles
+	192:  true, // diamond_block
+	190:  true, // diamond_ore
+	4:    true, // diorite
+	791:  true, // diorite_slab
+	778:  true, // diorite_stairs
+	804:  true, // diorite_wall
+	105:  true, // dispenser
+	1043: true, // dripstone_block
+	451:  true, // dropper
+	372:  true, // emerald_block
+	367:  true, // emerald_ore
+	354:  true, // enchanting_table
+	362:  true, // end_stone
+	784:  true, // end_stone_brick_slab
+	770:  true, // end_stone_brick_stairs
+	803:  true, // end_stone_brick_wall
+	629:  true, // end_stone_bricks
+	369:  true, // ender_chest
+	979:  true, // exposed_chiseled_copper
+	968:  true, // exposed_copper
+	1034: true, // exposed_copper_bulb
+	1010: true, // exposed_copper_door
+	1026: true, // exposed_copper_grate
+	1018: true, // exposed_copper_trapdoor
+	975:  true, // exposed_cut_copper
+	991:  true, // exposed_cut_copper_slab
+	987:  true, // exposed_cut_copper_stairs
+	724:  true, // fire_coral_block
+	196:  true, // furnace
+	895:  true, // gilded_blackstone
+	173:  true, // gold_block
+	42:   true, // gold_ore
+	2:    true, // granite
+	787:  true, // granite_slab
+	774:  true, // granite_stairs
+	796:  true, // granite_wall
+	685:  true, // gray_concrete
+	669:  true, // gray_glazed_terracotta
+	653:  true, // gray_shulker_box
+	459:  true, // gray_terracotta
+	691:  true, // green_concrete
+	675:  true, // green_glazed_terracotta
+	659:  true, // green_shulker_box
+	465:  true, // green_terracotta
+	812:  true, // grindstone
+	440:  true, // heavy_weighted_pressure_plate
+	445:  true, // hopper
+	725:  true, // horn_coral_block
+	322:  true, // infested_chiseled_stone_bricks
+	318:  true, // infested_cobblestone
+	321:  true, // infested_cracked_stone_bricks
+	1080: true, // infested_deepslate
+	317:  true, // infested_stone
+	319:  true, // infested_stone_bricks
+	326:  true, // iron_bars
+	174:  true, // iron_block
+	247:  true, // iron_door
+	44:   true, // iron_ore
+	494:  true, // iron_trapdoor
+	817:  true, // lantern
+	104:  true, // lapis_block
+	102:  true, // lapis_ore
+	941:  true, // large_amethyst_bud
+	358:  true, // lava_cauldron
+	681:  true, // light_blue_concrete
+	665:  true, // light_blue_glazed_terracotta
+	649:  true, // light_blue_shulker_box
+	455:  true, // light_blue_terracotta
+	686:  true, // light_gray_concrete
+	670:  true, // light_gray_glazed_terracotta
+	654:  true, // light_gray_shulker_box
+	460:  true, // light_gray_terracotta
+	439:  true, // light_weighted_pressure_plate
+	1041: true, // lightning_rod
+	683:  true, // lime_concrete
+	667:  true, // lime_glazed_terracotta
+	651:  true, // lime_shulker_box
+	457:  true, // lime_terracotta
+	883:  true, // lodestone
+	680:  true, // magenta_concrete
+	664:  true, // magenta_glazed_terracotta
+	648:  true, // magenta_shulker_box
+	454:  true, // magenta_terracotta
+	639:  true, // magma_block
+	942:  true, // medium_amethyst_bud
+	351:  true, // nether_brick_fence
+	587:  true, // nether_brick_slab
+	352:  true, // nether_brick_stairs
+	799:  true, // nether_brick_wall
+	350:  true, // nether_bricks
+	48:   true, // nether_gold_ore
+	444:  true, // nether_quartz_ore
+	875:  true, // netherite_block
+	272:  true, // netherrack
+	644:  true, // observer
+	180:  true, // obsidian
+	679:  true, // orange_concrete
+	663:  true, // orange_glazed_terracotta
+	647:  true, // orange_shulker_box
+	453:  true, // orange_terracotta
+	977:  true, // oxidized_chiseled_copper
+	970:  true, // oxidized_copper
+	1036: true, // oxidized_copper_bulb
+	1011: true, // oxidized_copper_door
+	1028: true, // oxidized_copper_grate
+	1019: true, // oxidized_copper_trapdoor
+	973:  true, // oxidized_cut_copper
+	989:  true, // oxidized_cut_copper_slab
+	985:  true, // oxidized_cut_copper_stairs
+	684:  true, // pink_concrete
+	668:  true, // pink_glazed_terracotta
+	652:  true, // pink_shulker_box
+	458:  true, // pink_terracotta
+	1042: true, // pointed_dripstone
+	7:    true, // polished_andesite
+	790:  true, // polished_andesite_slab
+	777:  true, // polished_andesite_stairs
+	276:  true, // polished_basalt
+	888:  true, // polished_blackstone
+	892:  true, // polished_blackstone_brick_slab
+	893:  true, // polished_blackstone_brick_stairs
+	894:  true, // polished_blackstone_brick_wall
+	889:  true, // polished_blackstone_bricks
+	898:  true, // polished_blackstone_pressure_plate
+	897:  true, // polished_blackstone_slab
+	896:  true, // polished_blackstone_stairs
+	900:  true, // polished_blackstone_wall
+	1065: true, // polished_deepslate
+	1067: true, // polished_deepslate_slab
+	1066: true, // polished_deepslate_stairs
+	1068: true, // polished_deepslate_wall
+	5:    true, // polished_diorite
+	782:  true, // polished_diorite_slab
+	768:  true, // polished_diorite_stairs
+	3:    true, // polished_granite
+	779:  true, // polished_granite_slab
+	765:  true, // polished_granite_stairs
+	948:  true, // polished_tuff
+	949:  true, // polished_tuff_slab
+	950:  true, // polished_tuff_stairs
+	951:  true, // polished_tuff_wall
+	495:  true, // prismarine
+	502:  true, // prismarine_brick_slab
+	499:  true, // prismarine_brick_stairs
+	496:  true, // prismarine_bricks
+	501:  true, // prismarine_slab
+	498:  true, // prismarine_stairs
+	793:  true, // prismarine_wall
+	688:  true, // purple_concrete
+	672:  true, // purple_glazed_terracotta
+	656:  true, // purple_shulker_box
+	462:  true, // purple_terracotta
+	626:  true, // purpur_block
+	627:  true, // purpur_pillar
+	591:  true, // purpur_slab
+	628:  true, // purpur_stairs
+	446:  true, // quartz_block
+	903:  true, // quartz_bricks
+	448:  true, // quartz_pillar
+	588:  true, // quartz_slab
+	449:  true, // quartz_stairs
+	1083: true, // raw_copper_block
+	1084: true, // raw_gold_block
+	1082: true, // raw_iron_block
+	692:  true, // red_concrete
+	676:  true, // red_glazed_terracotta
+	789:  true, // red_nether_brick_slab
+	776:  true, // red_nether_brick_stairs
+	801:  true, // red_nether_brick_wall
+	641:  true, // red_nether_bricks
+	563:  true, // red_sandstone
+	589:  true, // red_sandstone_slab
+	566:  true, // red_sandstone_stairs
+	794:  true, // red_sandstone_wall
+	660:  true, // red_shulker_box
+	466:  true, // red_terracotta
+	443:  true, // redstone_block
+	258:  true, // redstone_ore
+	1091: true, // reinforced_deepslate
+	347:  true, // resin_brick_slab
+	346:  true, // resin_brick_stairs
+	348:  true, // resin_brick_wall
+	345:  true, // resin_bricks
+	878:  true, // respawn_anchor
+	106:  true, // sandstone
+	580:  true, // sandstone_slab
+	366:  true, // sandstone_stairs
+	802:  true, // sandstone_wall
+	645:  true, // shulker_box
+	943:  true, // small_amethyst_bud
+	808:  true, // smoker
+	1081: true, // smooth_basalt
+	594:  true, // smooth_quartz
+	786:  true, // smooth_quartz_slab
+	773:  true, // smooth_quartz_stairs
+	595:  true, // smooth_red_sandstone
+	780:  true, // smooth_red_sandstone_slab
+	766:  true, // smooth_red_sandstone_stairs
+	593:  true, // smooth_sandstone
+	785:  true, // smooth_sandstone_slab
+	772:  true, // smooth_sandstone_stairs
+	592:  true, // smooth_stone
+	579:  true, // smooth_stone_slab
+	263:  true, // snow
+	265:  true, // snow_block
+	818:  true, // soul_lantern
+	185:  true, // spawner
+	1:    true, // stone
+	585:  true, // stone_brick_slab
+	340:  true, // stone_brick_stairs
+	797:  true, // stone_brick_wall
+	311:  true, // stone_bricks
+	246:  true, // stone_pressure_plate
+	578:  true, // stone_slab
+	771:  true, // stone_stairs
+	815:  true, // stonecutter
+	522:  true, // terracotta
+	1094: true, // trial_spawner
+	721:  true, // tube_coral_block
+	944:  true, // tuff
+	954:  true, // tuff_brick_slab
+	955:  true, // tuff_brick_stairs
+	956:  true, // tuff_brick_wall
+	953:  true, // tuff_bricks
+	945:  true, // tuff_slab
+	946:  true, // tuff_stairs
+	947:  true, // tuff_wall
+	1095: true, // vault
+	826:  true, // warped_nylium
+	357:  true, // water_cauldron
+	984:  true, // waxed_chiseled_copper
+	993:  true, // waxed_copper_block
+	1037: true, // waxed_copper_bulb
+	1013: true, // waxed_copper_door
+	1029: true, // waxed_copper_grate
+	1021: true, // waxed_copper_trapdoor
+	1000: true, // waxed_cut_copper
+	1008: true, // waxed_cut_copper_slab
+	1004: true, // waxed_cut_copper_stairs
+	983:  true, // waxed_exposed_chiseled_copper
+	995:  true, // waxed_exposed_copper
+	1038: true, // waxed_exposed_copper_bulb
+	1014: true, // waxed_exposed_copper_door
+	1030: true, // waxed_exposed_copper_grate
+	1022: true, // waxed_exposed_copper_trapdoor
+	999:  true, // waxed_exposed_cut_copper
+	1007: true, // waxed_exposed_cut_copper_slab
+	1003: true, // waxed_exposed_cut_copper_stairs
+	981:  true, // waxed_oxidized_chiseled_copper
+	996:  true, // waxed_oxidized_copper
+	1040: true, // waxed_oxidized_copper_bulb
+	1015: true, // waxed_oxidized_copper_door
+	1032: true, // waxed_oxidized_copper_grate
+	1023: true, // waxed_oxidized_copper_trapdoor
+	997:  true, // waxed_oxidized_cut_copper
+	1005: true, // waxed_oxidized_cut_copper_slab
+	1001: true, // waxed_oxidized_cut_copper_stairs
+	982:  true, // waxed_weathered_chiseled_copper
+	994:  true, // waxed_weathered_copper
+	1039: true, // waxed_weathered_copper_bulb
+	1016: true, // waxed_weathered_copper_door
+	1031: true, // waxed_weathered_copper_grate
+	1024: true, // waxed_weathered_copper_trapdoor
+	998:  true, // waxed_weathered_cut_copper
+	1006: true, // waxed_weathered_cut_copper_slab
+	1002: true, // waxed_weathered_cut_copper_stairs
+	978:  true, // weathered_chiseled_copper
+	969:  true, // weathered_copper
+	1035: true, // weathered_copper_bulb
+	1012: true, // weathered_copper_door
+	1027: true, // weathered_copper_grate
+	1020: true, // weathered_copper_trapdoor
+	974:  true, // weathered_cut_copper
+	990:  true, // weathered_cut_copper_slab
+	986:  true, // weathered_cut_copper_stairs
+	678:  true, // white_concrete
+	662:  true, // white_glazed_terracotta
+	646:  true, // white_shulker_box
+	452:  true, // white_terracotta
+	682:  true, // yellow_concrete
+	666:  true, // yellow_glazed_terracotta
+	650:  true, // yellow_shulker_box
+	456:  true, // yellow_terracotta
+}
+
+// ResistanceMap stores block blast resistance values (Minecraft 1.21.10)
+var ResistanceMap = map[int]float64{
+	415:  0.5,           // acacia_button
+	617:  3,             // acacia_door
+	608:  3,             // acacia_fence
+	599:  3,             // acacia_fence_gate
+	224:  1,             // acacia_hanging_sign
+	92:   0.2,           // acacia_leaves
+	53:   2,             // acacia_log
+	17:   3,             // acacia_planks
+	252:  0.5,           // acacia_pressure_plate
+	29:   0,             // acacia_sapling
+	200:  1,             // acacia_sign
+	571:  3,             // acacia_slab
+	484:  3,             // acacia_stairs
+	305:  3,             // acacia_trapdoor
+	236:  1,             // acacia_wall_hanging_sign
+	214:  1,             // acacia_wall_sign
+	75:   2,             // acacia_wood
+	450:  0.7,           // activator_rail
+	0:    0,             // air
+	161:  0,             // allium
+	938:  1.5,           // amethyst_block
+	940:  1.5,           // amethyst_cluster
+	876:  1200,          // ancient_debris
+	6:    6,             // andesite
+	788:  6,             // andesite_slab
+	775:  6,             // andesite_stairs
+	800:  6,             // andesite_wall
+	435:  1200,          // anvil
+	332:  0,             // attached_melon_stem
+	331:  0,             // attached_pumpkin_stem
+	1047: 0,             // azalea
+	97:   0.2,           // azalea_leaves
+	162:  0,             // azure_bluet
+	760:  1,             // bamboo
+	60:   2,             // bamboo_block
+	420:  0.5,           // bamboo_button
+	622:  3,             // bamboo_door
+	613:  3,             // bamboo_fence
+	604:  3,             // bamboo_fence_gate
+	232:  1,             // bamboo_hanging_sign
+	24:   3,             // bamboo_mosaic
+	577:  3,             // bamboo_mosaic_slab
+	490:  3,             // bamboo_mosaic_stairs
+	23:   3,             // bamboo_planks
+	257:  0.5,           // bamboo_pressure_plate
+	759:  1,             // bamboo_sapling
+	206:  1,             // bamboo_sign
+	576:  3,             // bamboo_slab
+	489:  3,             // bamboo_stairs
+	310:  3,             // bamboo_trapdoor
+	244:  1,             // bamboo_wall_hanging_sign
+	220:  1,             // bamboo_wall_sign
+	807:  2.5,           // barrel
+	492:  3.6000008e+06, // barrier
+	275:  4.2,           // basalt
+	377:  3,             // beacon
+	34:   3.6e+06,       // bedrock
+	871:  0.3,           // bee_nest
+	872:  0.6,           // beehive
+	633:  0,             // beetroots
+	816:  5,             // bell
+	1054: 0.1,           // big_dripleaf
+	1055: 0.1,           // big_dripleaf_stem
+	413:  0.5,           // birch_button
+	615:  3,             // birch_door
+	606:  3,             // birch_fence
+	597:  3,             // birch_fence_gate
+	223:  1,             // birch_hanging_sign
+	90:   0.2,           // birch_leaves
+	51:   2,             // birch_log
+	15:   3,             // birch_planks
+	250:  0.5,           // birch_pressure_plate
+	27:   0,             // birch_sapling
+	199:  1,             // birch_sign
+	569:  3,             // birch_slab
+	374:  3,             // birch_stairs
+	303:  3,             // birch_trapdoor
+	235:  1,             // birch_wall_hanging_sign
+	213:  1,             // birch_wall_sign
+	73:   2,             // birch_wood
+	546:  1,             // black_banner
+	125:  0.2,           // black_bed
+	920:  0.1,           // black_candle
+	937:  0.5,           // black_candle_cake
+	521:  0.1,           // black_carpet
+	693:  1.8,           // black_concrete
+	709:  0.5,           // black_concrete_powder
+	677:  1.4,           // black_glazed_terracotta
+	661:  2,             // black_shulker_box
+	300:  0.3,           // black_stained_glass
+	483:  0.3,           // black_stained_glass_pane
+	467:  4.2,           // black_terracotta
+	562:  1,             // black_wall_banner
+	155:  0.8,           // black_wool
+	884:  6,             // blackstone
+	887:  6,             // blackstone_slab
+	885:  6,             // blackstone_stairs
+	886:  6,             // blackstone_wall
+	809:  3.5,           // blast_furnace
+	542:  1,             // blue_banner
+	121:  0.2,           // blue_bed
+	916:  0.1,           // blue_candle
+	933:  0.5,           // blue_candle_cake
+	517:  0.1,           // blue_carpet
+	689:  1.8,           // blue_concrete
+	705:  0.5,           // blue_concrete_powder
+	673:  1.4,           // blue_glazed_terracotta
+	757:  2.8,           // blue_ice
+	160:  0,             // blue_orchid
+	657:  2,             // blue_shulker_box
+	296:  0.3,           // blue_stained_glass
+	479:  0.3,           // blue_stained_glass_pane
+	463:  4.2,           // blue_terracotta
+	558:  1,             // blue_wall_banner
+	151:  0.8,           // blue_wool
+	642:  2,             // bone_block
+	177:  1.5,           // bookshelf
+	732:  0,             // brain_coral
+	722:  1.5,           // brain_coral_block
+	742:  0,             // brain_coral_fan
+	752:  0,             // brain_coral_wall_fan
+	355:  0.5,           // brewing_stand
+	584:  6,             // brick_slab
+	339:  6,             // brick_stairs
+	792:  6,             // brick_wall
+	175:  6,             // bricks
+	543:  1,             // brown_banner
+	122:  0.2,           // brown_bed
+	917:  0.1,           // brown_candle
+	934:  0.5,           // brown_candle_cake
+	518:  0.1,           // brown_carpet
+	690:  1.8,           // brown_concrete
+	706:  0.5,           // brown_concrete_powder
+	674:  1.4,           // brown_glazed_terracotta
+	171:  0,             // brown_mushroom
+	323:  0.2,           // brown_mushroom_block
+	658:  2,             // brown_shulker_box
+	297:  0.3,           // brown_stained_glass
+	480:  0.3,           // brown_stained_glass_pane
+	464:  4.2,           // brown_terracotta
+	559:  1,             // brown_wall_banner
+	152:  0.8,           // brown_wool
+	764:  0,             // bubble_column
+	733:  0,             // bubble_coral
+	723:  1.5,           // bubble_coral_block
+	743:  0,             // bubble_coral_fan
+	753:  0,             // bubble_coral_wall_fan
+	939:  1.5,           // budding_amethyst
+	133:  0,             // bush
+	266:  0.4,           // cactus
+	267:  0,             // cactus_flower
+	283:  0.5,           // cake
+	958:  0.8,           // calcite
+	962:  1.5,           // calibrated_sculk_sensor
+	819:  2,             // campfire
+	904:  0.1,           // candle
+	921:  0.5,           // candle_cake
+	409:  0,             // carrots
+	810:  2.5,           // cartography_table
+	281:  1,             // carved_pumpkin
+	356:  2,             // cauldron
+	763:  0,             // cave_air
+	1044: 0,             // cave_vines
+	1045: 0,             // cave_vines_plant
+	327:  6,             // chain
+	637:  3.6e+06,       // chain_command_block
+	416:  0.5,           // cherry_button
+	618:  3,             // cherry_door
+	609:  3,             // cherry_fence
+	600:  3,             // cherry_fence_gate
+	225:  1,             // cherry_hanging_sign
+	93:   0.2,           // cherry_leaves
+	54:   2,             // cherry_log
+	18:   3,             // cherry_planks
+	253:  0.5,           // cherry_pressure_plate
+	30:   0,             // cherry_sapling
+	201:  1,             // cherry_sign
+	572:  3,             // cherry_slab
+	485:  3,             // cherry_stairs
+	306:  3,             // cherry_trapdoor
+	237:  1,             // cherry_wall_hanging_sign
+	215:  1,             // cherry_wall_sign
+	76:   2,             // cherry_wood
+	188:  2.5,           // chest
+	436:  1200,          // chipped_anvil
+	178:  1.5,           // chiseled_bookshelf
+	980:  6,             // chiseled_copper
+	1077: 6,             // chiseled_deepslate
+	901:  6,             // chiseled_nether_bricks
+	891:  1.5,           // chiseled_polished_blackstone
+	447:  0.8,           // chiseled_quartz_block
+	564:  0.8,           // chiseled_red_sandstone
+	349:  6,             // chiseled_resin_bricks
+	107:  0.8,           // chiseled_sandstone
+	314:  6,             // chiseled_stone_bricks
+	952:  6,             // chiseled_tuff
+	957:  6,             // chiseled_tuff_bricks
+	625:  0.4,           // chorus_flower
+	624:  0.4,           // chorus_plant
+	268:  0.6,           // clay
+	1101: 0,             // closed_eyeblossom
+	523:  6,             // coal_block
+	46:   3,             // coal_ore
+	10:   0.5,           // coarse_dirt
+	1061: 6,             // cobbled_deepslate
+	1063: 6,             // cobbled_deepslate_slab
+	1062: 6,             // cobbled_deepslate_stairs
+	1064: 6,             // cobbled_deepslate_wall
+	12:   6,             // cobblestone
+	583:  6,             // cobblestone_slab
+	210:  6,             // cobblestone_stairs
+	378:  6,             // cobblestone_wall
+	129:  4,             // cobweb
+	365:  0.2,           // cocoa
+	376:  3.6e+06,       // command_block
+	441:  0,             // comparator
+	869:  0.6,           // composter
+	758:  3,             // conduit
+	967:  6,             // copper_block
+	1033: 6,             // copper_bulb
+	1009: 6,             // copper_door
+	1025: 6,             // copper_grate
+	971:  3,             // copper_ore
+	1017: 6,             // copper_trapdoor
+	168:  0,             // cornflower
+	1078: 6,             // cracked_deepslate_bricks
+	1079: 6,             // cracked_deepslate_tiles
+	902:  6,             // cracked_nether_bricks
+	890:  6,             // cracked_polished_blackstone_bricks
+	313:  6,             // cracked_stone_bricks
+	1093: 3.5,           // crafter
+	193:  2.5,           // crafting_table
+	186:  10,            // creaking_heart
+	429:  1,             // creeper_head
+	430:  1,             // creeper_wall_head
+	857:  0.5,           // crimson_button
+	859:  3,             // crimson_door
+	849:  3,             // crimson_fence
+	853:  3,             // crimson_fence_gate
+	836:  0,             // crimson_fungus
+	229:  1,             // crimson_hanging_sign
+	833:  2,             // crimson_hyphae
+	835:  0.4,           // crimson_nylium
+	843:  3,             // crimson_planks
+	847:  0.5,           // crimson_pressure_plate
+	842:  0,             // crimson_roots
+	861:  1,             // crimson_sign
+	845:  3,             // crimson_slab
+	855:  3,             // crimson_stairs
+	831:  2,             // crimson_stem
+	851:  3,             // crimson_trapdoor
+	242:  1,             // crimson_wall_hanging_sign
+	863:  1,             // crimson_wall_sign
+	877:  1200,          // crying_obsidian
+	976:  6,             // cut_copper
+	992:  6,             // cut_copper_slab
+	988:  6,             // cut_copper_stairs
+	565:  0.8,           // cut_red_sandstone
+	590:  2,             // cut_red_sandstone_slab
+	108:  0.8,           // cut_sandstone
+	581:  2,             // cut_sandstone_slab
+	540:  1,             // cyan_banner
+	119:  0.2,           // cyan_bed
+	914:  0.1,           // cyan_candle
+	931:  0.5,           // cyan_candle_cake
+	515:  0.1,           // cyan_carpet
+	687:  1.8,           // cyan_concrete
+	703:  0.5,           // cyan_concrete_powder
+	671:  1.4,           // cyan_glazed_terracotta
+	655:  2,             // cyan_shulker_box
+	294:  0.3,           // cyan_stained_glass
+	477:  0.3,           // cyan_stained_glass_pane
+	461:  4.2,           // cyan_terracotta
+	556:  1,             // cyan_wall_banner
+	149:  0.8,           // cyan_wool
+	437:  1200,          // damaged_anvil
+	157:  0,             // dandelion
+	417:  0.5,           // dark_oak_button
+	619:  3,             // dark_oak_door
+	610:  3,             // dark_oak_fence
+	601:  3,             // dark_oak_fence_gate
+	227:  1,             // dark_oak_hanging_sign
+	94:   0.2,           // dark_oak_leaves
+	55:   2,             // dark_oak_log
+	19:   3,             // dark_oak_planks
+	254:  0.5,           // dark_oak_pressure_plate
+	31:   0,             // dark_oak_sapling
+	203:  1,             // dark_oak_sign
+	573:  3,             // dark_oak_slab
+	486:  3,             // dark_oak_stairs
+	307:  3,             // dark_oak_trapdoor
+	239:  1,             // dark_oak_wall_hanging_sign
+	217:  1,             // dark_oak_wall_sign
+	77:   2,             // dark_oak_wood
+	497:  6,             // dark_prismarine
+	503:  6,             // dark_prismarine_slab
+	500:  6,             // dark_prismarine_stairs
+	442:  0.2,           // daylight_detector
+	727:  0,             // dead_brain_coral
+	717:  1.5,           // dead_brain_coral_block
+	737:  0,             // dead_brain_coral_fan
+	747:  0,             // dead_brain_coral_wall_fan
+	728:  0,             // dead_bubble_coral
+	718:  1.5,           // dead_bubble_coral_block
+	738:  0,             // dead_bubble_coral_fan
+	748:  0,             // dead_bubble_coral_wall_fan
+	132:  0,             // dead_bush
+	729:  0,             // dead_fire_coral
+	719:  1.5,           // dead_fire_coral_block
+	739:  0,             // dead_fire_coral_fan
+	749:  0,             // dead_fire_coral_wall_fan
+	730:  0,             // dead_horn_coral
+	720:  1.5,           // dead_horn_coral_block
+	740:  0,             // dead_horn_coral_fan
+	750:  0,             // dead_horn_coral_wall_fan
+	726:  0,             // dead_tube_coral
+	716:  1.5,           // dead_tube_coral_block
+	736:  0,             // dead_tube_coral_fan
+	746:  0,             // dead_tube_coral_wall_fan
+	1092: 0,             // decorated_pot
+	1060: 6,             // deepslate
+	1075: 6,             // deepslate_brick_slab
+	1074: 6,             // deepslate_brick_stairs
+	1076: 6,             // deepslate_brick_wall
+	1073: 6,             // deepslate_bricks
+	47:   3,             // deepslate_coal_ore
+	972:  3,             // deepslate_copper_ore
+	191:  3,             // deepslate_diamond_ore
+	368:  3,             // deepslate_emerald_ore
+	43:   3,             // deepslate_gold_ore
+	45:   3,             // deepslate_iron_ore
+	103:  3,             // deepslate_lapis_ore
+	259:  3,             // deepslate_redstone_ore
+	1071: 6,             // deepslate_tile_slab
+	1070: 6,             // deepslate_tile_stairs
+	1072: 6,             // deepslate_tile_wall
+	1069: 6,             // deepslate_tiles
+	127:  0.7,           // detector_rail
+	192:  6,             // diamond_block
+	190:  3,             // diamond_ore
+	4:    6,             // diorite
+	791:  6,             // diorite_slab
+	778:  6,             // diorite_stairs
+	804:  6,             // diorite_wall
+	9:    0.5,           // dirt
+	634:  0.7,           // dirt_path
+	105:  3.5,           // dispenser
+	363:  9,             // dragon_egg
+	431:  1,             // dragon_head
+	432:  1,             // dragon_wall_head
+	715:  0,             // dried_ghast
+	712:  0.5,           // dried_kelp_block
+	1043: 1.5,           // dripstone_block
+	451:  3.5,           // dropper
+	372:  6,             // emerald_block
+	367:  3,             // emerald_ore
+	354:  1200,          // enchanting_table
+	635:  3.6e+06,       // end_gateway
+	360:  3.6e+06,       // end_portal
+	361:  3.6e+06,       // end_portal_frame
+	623:  0,             // end_rod
+	362:  9,             // end_stone
+	784:  9,             // end_stone_brick_slab
+	770:  9,             // end_stone_brick_stairs
+	803:  9,             // end_stone_brick_wall
+	629:  9,             // end_stone_bricks
+	369:  600,           // ender_chest
+	979:  6,             // exposed_chiseled_copper
+	968:  6,             // exposed_copper
+	1034: 6,             // exposed_copper_bulb
+	1010: 6,             // exposed_copper_door
+	1026: 6,             // exposed_copper_grate
+	1018: 6,             // exposed_copper_trapdoor
+	975:  6,             // exposed_cut_copper
+	991:  6,             // exposed_cut_copper_slab
+	987:  6,             // exposed_cut_copper_stairs
+	195:  0.6,           // farmland
+	131:  0,             // fern
+	183:  0,             // fire
+	734:  0,             // fire_coral
+	724:  1.5,           // fire_coral_block
+	744:  0,             // fire_coral_fan
+	754:  0,             // fire_coral_wall_fan
+	1104: 0,             // firefly_bush
+	811:  2.5,           // fletching_table
+	380:  0,             // flower_pot
+	1048: 0,             // flowering_azalea
+	98:   0.2,           // flowering_azalea_leaves
+	1090: 0,             // frogspawn
+	638:  0.5,           // frosted_ice
+	196:  3.5,           // furnace
+	895:  1.5,           // gilded_blackstone
+	101:  0.3,           // glass
+	328:  0.3,           // glass_pane
+	336:  0.2,           // glow_lichen
+	279:  0.3,           // glowstone
+	173:  6,             // gold_block
+	42:   3,             // gold_ore
+	2:    6,             // granite
+	787:  6,             // granite_slab
+	774:  6,             // granite_stairs
+	796:  6,             // granite_wall
+	8:    0.6,           // grass_block
+	40:   0.6,           // gravel
+	538:  1,             // gray_banner
+	117:  0.2,           // gray_bed
+	912:  0.1,           // gray_candle
+	929:  0.5,           // gray_candle_cake
+	513:  0.1,           // gray_carpet
+	685:  1.8,           // gray_concrete
+	701:  0.5,           // gray_concrete_powder
+	669:  1.4,           // gray_glazed_terracotta
+	653:  2,             // gray_shulker_box
+	292:  0.3,           // gray_stained_glass
+	475:  0.3,           // gray_stained_glass_pane
+	459:  4.2,           // gray_terracotta
+	554:  1,             // gray_wall_banner
+	147:  0.8,           // gray_wool
+	544:  1,             // green_banner
+	123:  0.2,           // green_bed
+	918:  0.1,           // green_candle
+	935:  0.5,           // green_candle_cake
+	519:  0.1,           // green_carpet
+	691:  1.8,           // green_concrete
+	707:  0.5,           // green_concrete_powder
+	675:  1.4,           // green_glazed_terracotta
+	659:  2,             // green_shulker_box
+	298:  0.3,           // green_stained_glass
+	481:  0.3,           // green_stained_glass_pane
+	465:  4.2,           // green_terracotta
+	560:  1,             // green_wall_banner
+	153:  0.8,           // green_wool
+	812:  6,             // grindstone
+	1057: 0,             // hanging_roots
+	505:  0.5,           // hay_block
+	1096: 1200,          // heavy_core
+	440:  0.5,           // heavy_weighted_pressure_plate
+	873:  0,             // honey_block
+	874:  0.6,           // honeycomb_block
+	445:  4.8,           // hopper
+	735:  0,             // horn_coral
+	725:  1.5,           // horn_coral_block
+	745:  0,             // horn_coral_fan
+	755:  0,             // horn_coral_wall_fan
+	264:  0.5,           // ice
+	322:  0.75,          // infested_chiseled_stone_bricks
+	318:  0.75,          // infested_cobblestone
+	321:  0.75,          // infested_cracked_stone_bricks
+	1080: 0.75,          // infested_deepslate
+	320:  0.75,          // infested_mossy_stone_bricks
+	317:  0.75,          // infested_stone
+	319:  0.75,          // infested_stone_bricks
+	326:  6,             // iron_bars
+	174:  6,             // iron_block
+	247:  5,             // iron_door
+	44:   3,             // iron_ore
+	494:  5,             // iron_trapdoor
+	282:  1,             // jack_o_lantern
+	866:  3.6e+06,       // jigsaw
+	270:  2,             // jukebox
+	414:  0.5,           // jungle_button
+	616:  3,             // jungle_door
+	607:  3,             // jungle_fence
+	598:  3,             // jungle_fence_gate
+	226:  1,             // jungle_hanging_sign
+	91:   0.2,           // jungle_leaves
+	52:   2,             // jungle_log
+	16:   3,             // jungle_planks
+	251:  0.5,           // jungle_pressure_plate
+	28:   0,             // jungle_sapling
+	202:  1,             // jungle_sign
+	570:  3,             // jungle_slab
+	375:  3,             // jungle_stairs
+	304:  3,             // jungle_trapdoor
+	238:  1,             // jungle_wall_hanging_sign
+	216:  1,             // jungle_wall_sign
+	74:   2,             // jungle_wood
+	710:  0,             // kelp
+	711:  0,             // kelp_plant
+	208:  0.4,           // ladder
+	817:  3.5,           // lantern
+	104:  6,             // lapis_block
+	102:  3,             // lapis_ore
+	941:  1.5,           // large_amethyst_bud
+	530:  0,             // large_fern
+	36:   100,           // lava
+	358:  2,             // lava_cauldron
+	1052: 0,             // leaf_litter
+	813:  2.5,           // lectern
+	245:  0.5,           // lever
+	493:  3.6000008e+06, // light
+	534:  1,             // light_blue_banner
+	113:  0.2,           // light_blue_bed
+	908:  0.1,           // light_blue_candle
+	925:  0.5,           // light_blue_candle_cake
+	509:  0.1,           // light_blue_carpet
+	681:  1.8,           // light_blue_concrete
+	697:  0.5,           // light_blue_concrete_powder
+	665:  1.4,           // light_blue_glazed_terracotta
+	649:  2,             // light_blue_shulker_box
+	288:  0.3,           // light_blue_stained_glass
+	471:  0.3,           // light_blue_stained_glass_pane
+	455:  4.2,           // light_blue_terracotta
+	550:  1,             // light_blue_wall_banner
+	143:  0.8,           // light_blue_wool
+	539:  1,             // light_gray_banner
+	118:  0.2,           // light_gray_bed
+	913:  0.1,           // light_gray_candle
+	930:  0.5,           // light_gray_candle_cake
+	514:  0.1,           // light_gray_carpet
+	686:  1.8,           // light_gray_concrete
+	702:  0.5,           // light_gray_concrete_powder
+	670:  1.4,           // light_gray_glazed_terracotta
+	654:  2,             // light_gray_shulker_box
+	293:  0.3,           // light_gray_stained_glass
+	476:  0.3,           // light_gray_stained_glass_pane
+	460:  4.2,           // light_gray_terracotta
+	555:  1,             // light_gray_wall_banner
+	148:  0.8,           // light_gray_wool
+	439:  0.5,           // light_weighted_pressure_plate
+	1041: 6,             // lightning_rod
+	526:  0,             // lilac
+	170:  0,             // lily_of_the_valley
+	343:  0,             // lily_pad
+	536:  1,             // lime_banner
+	115:  0.2,           // lime_bed
+	910:  0.1,           // lime_candle
+	927:  0.5,           // lime_candle_cake
+	511:  0.1,           // lime_carpet
+	683:  1.8,           // lime_concrete
+	699:  0.5,           // lime_concrete_powder
+	667:  1.4,           // lime_glazed_terracotta
+	651:  2,             // lime_shulker_box
+	290:  0.3,           // lime_stained_glass
+	473:  0.3,           // lime_stained_glass_pane
+	457:  4.2,           // lime_terracotta
+	552:  1,             // lime_wall_banner
+	145:  0.8,           // lime_wool
+	883:  3.5,           // lodestone
+	806:  2.5,           // loom
+	533:  1,             // magenta_banner
+	112:  0.2,           // magenta_bed
+	907:  0.1,           // magenta_candle
+	924:  0.5,           // magenta_candle_cake
+	508:  0.1,           // magenta_carpet
+	680:  1.8,           // magenta_concrete
+	696:  0.5,           // magenta_concrete_powder
+	664:  1.4,           // magenta_glazed_terracotta
+	648:  2,             // magenta_shulker_box
+	287:  0.3,           // magenta_stained_glass
+	470:  0.3,           // magenta_stained_glass_pane
+	454:  4.2,           // magenta_terracotta
+	549:  1,             // magenta_wall_banner
+	142:  0.8,           // magenta_wool
+	639:  0.5,           // magma_block
+	419:  0.5,           // mangrove_button
+	621:  3,             // mangrove_door
+	612:  3,             // mangrove_fence
+	603:  3,             // mangrove_fence_gate
+	231:  1,             // mangrove_hanging_sign
+	96:   0.2,           // mangrove_leaves
+	57:   2,             // mangrove_log
+	22:   3,             // mangrove_planks
+	256:  0.5,           // mangrove_pressure_plate
+	33:   0,             // mangrove_propagule
+	58:   0.7,           // mangrove_roots
+	205:  1,             // mangrove_sign
+	575:  3,             // mangrove_slab
+	488:  3,             // mangrove_stairs
+	309:  3,             // mangrove_trapdoor
+	241:  1,             // mangrove_wall_hanging_sign
+	219:  1,             // mangrove_wall_sign
+	78:   2,             // mangrove_wood
+	942:  1.5,           // medium_amethyst_bud
+	330:  1,             // melon
+	334:  0,             // melon_stem
+	1053: 0.1,           // moss_block
+	1049: 0.1,           // moss_carpet
+	179:  6,             // mossy_cobblestone
+	783:  6,             // mossy_cobblestone_slab
+	769:  6,             // mossy_cobblestone_stairs
+	379:  6,             // mossy_cobblestone_wall
+	781:  6,             // mossy_stone_brick_slab
+	767:  6,             // mossy_stone_brick_stairs
+	795:  6,             // mossy_stone_brick_wall
+	312:  6,             // mossy_stone_bricks
+	156:  0,             // moving_piston
+	1059: 0.5,           // mud
+	586:  3,             // mud_brick_slab
+	341:  3,             // mud_brick_stairs
+	798:  3,             // mud_brick_wall
+	316:  3,             // mud_bricks
+	59:   0.7,           // muddy_mangrove_roots
+	325:  0.2,           // mushroom_stem
+	342:  0.6,           // mycelium
+	351:  6,             // nether_brick_fence
+	587:  6,             // nether_brick_slab
+	352:  6,             // nether_brick_stairs
+	799:  6,             // nether_brick_wall
+	350:  6,             // nether_bricks
+	48:   3,             // nether_gold_ore
+	280:  0,             // nether_portal
+	444:  3,             // nether_quartz_ore
+	830:  0,             // nether_sprouts
+	353:  0,             // nether_wart
+	640:  1,             // nether_wart_block
+	875:  1200,          // netherite_block
+	272:  0.4,           // netherrack
+	109:  0.8,           // note_block
+	411:  0.5,           // oak_button
+	207:  3,             // oak_door
+	271:  3,             // oak_fence
+	338:  3,             // oak_fence_gate
+	221:  1,             // oak_hanging_sign
+	88:   0.2,           // oak_leaves
+	49:   2,             // oak_log
+	13:   3,             // oak_planks
+	248:  0.5,           // oak_pressure_plate
+	25:   0,             // oak_sapling
+	197:  1,             // oak_sign
+	567:  3,             // oak_slab
+	187:  3,             // oak_stairs
+	301:  3,             // oak_trapdoor
+	233:  1,             // oak_wall_hanging_sign
+	211:  1,             // oak_wall_sign
+	71:   2,             // oak_wood
+	644:  3,             // observer
+	180:  1200,          // obsidian
+	1087: 0.3,           // ochre_froglight
+	1100: 0,             // open_eyeblossom
+	532:  1,             // orange_banner
+	111:  0.2,           // orange_bed
+	906:  0.1,           // orange_candle
+	923:  0.5,           // orange_candle_cake
+	507:  0.1,           // orange_carpet
+	679:  1.8,           // orange_concrete
+	695:  0.5,           // orange_concrete_powder
+	663:  1.4,           // orange_glazed_terracotta
+	647:  2,             // orange_shulker_box
+	286:  0.3,           // orange_stained_glass
+	469:  0.3,           // orange_stained_glass_pane
+	453:  4.2,           // orange_terracotta
+	164:  0,             // orange_tulip
+	548:  1,             // orange_wall_banner
+	141:  0.8,           // orange_wool
+	167:  0,             // oxeye_daisy
+	977:  6,             // oxidized_chiseled_copper
+	970:  6,             // oxidized_copper
+	1036: 6,             // oxidized_copper_bulb
+	1011: 6,             // oxidized_copper_door
+	1028: 6,             // oxidized_copper_grate
+	1019: 6,             // oxidized_copper_trapdoor
+	973:  6,             // oxidized_cut_copper
+	989:  6,             // oxidized_cut_copper_slab
+	985:  6,             // oxidized_cut_copper_stairs
+	524:  0.5,           // packed_ice
+	315:  1,             // packed_mud
+	1099: 0,             // pale_hanging_moss
+	1097: 0.1,           // pale_moss_block
+	1098: 0.1,           // pale_moss_carpet
+	418:  0.5,           // pale_oak_button
+	620:  3,             // pale_oak_door
+	611:  3,             // pale_oak_fence
+	602:  3,             // pale_oak_fence_gate
+	228:  1,             // pale_oak_hanging_sign
+	95:   0.2,           // pale_oak_leaves
+	56:   2,             // pale_oak_log
+	21:   3,             // pale_oak_planks
+	255:  0.5,           // pale_oak_pressure_plate
+	32:   0,             // pale_oak_sapling
+	204:  1,             // pale_oak_sign
+	574:  3,             // pale_oak_slab
+	487:  3,             // pale_oak_stairs
+	308:  3,             // pale_oak_trapdoor
+	240:  1,             // pale_oak_wall_hanging_sign
+	218:  1,             // pale_oak_wall_sign
+	20:   2,             // pale_oak_wood
+	1089: 0.3,           // pearlescent_froglight
+	528:  0,             // peony
+	582:  3,             // petrified_oak_slab
+	433:  1,             // piglin_head
+	434:  1,             // piglin_wall_head
+	537:  1,             // pink_banner
+	116:  0.2,           // pink_bed
+	911:  0.1,           // pink_candle
+	928:  0.5,           // pink_candle_cake
+	512:  0.1,           // pink_carpet
+	684:  1.8,           // pink_concrete
+	700:  0.5,           // pink_concrete_powder
+	668:  1.4,           // pink_glazed_terracotta
+	1050: 0,             // pink_petals
+	652:  2,             // pink_shulker_box
+	291:  0.3,           // pink_stained_glass
+	474:  0.3,           // pink_stained_glass_pane
+	458:  4.2,           // pink_terracotta
+	166:  0,             // pink_tulip
+	553:  1,             // pink_wall_banner
+	146:  0.8,           // pink_wool
+	138:  1.5,           // piston
+	139:  1.5,           // piston_head
+	631:  0,             // pitcher_crop
+	632:  0,             // pitcher_plant
+	427:  1,             // player_head
+	428:  1,             // player_wall_head
+	11:   0.5,           // podzol
+	1042: 1.5,           // pointed_dripstone
+	7:    6,             // polished_andesite
+	790:  6,             // polished_andesite_slab
+	777:  6,             // polished_andesite_stairs
+	276:  4.2,           // polished_basalt
+	888:  6,             // polished_blackstone
+	892:  6,             // polished_blackstone_brick_slab
+	893:  6,             // polished_blackstone_brick_stairs
+	894:  6,             // polished_blackstone_brick_wall
+	889:  6,             // polished_blackstone_bricks
+	899:  0.5,           // polished_blackstone_button
+	898:  0.5,           // polished_blackstone_pressure_plate
+	897:  6,             // polished_blackstone_slab
+	896:  6,             // polished_blackstone_stairs
+	900:  6,             // polished_blackstone_wall
+	1065: 6,             // polished_deepslate
+	1067: 6,             // polished_deepslate_slab
+	1066: 6,             // polished_deepslate_stairs
+	1068: 6,             // polished_deepslate_wall
+	5:    6,             // polished_diorite
+	782:  6,             // polished_diorite_slab
+	768:  6,             // polished_diorite_stairs
+	3:    6,             // polished_granite
+	779:  6,             // polished_granite_slab
+	765:  6,             // polished_granite_stairs
+	948:  6,             // polished_tuff
+	949:  6,             // polished_tuff_slab
+	950:  6,             // polished_tuff_stairs
+	951:  6,             // polished_tuff_wall
+	159:  0,             // poppy
+	410:  0,             // potatoes
+	386:  0,             // potted_acacia_sapling
+	395:  0,             // potted_allium
+	1085: 0,             // potted_azalea_bush
+	396:  0,             // potted_azure_bluet
+	761:  0,             // potted_bamboo
+	384:  0,             // potted_birch_sapling
+	394:  0,             // potted_blue_orchid
+	406:  0,             // potted_brown_mushroom
+	408:  0,             // potted_cactus
+	387:  0,             // potted_cherry_sapling
+	1103: 0,             // potted_closed_eyeblossom
+	402:  0,             // potted_cornflower
+	879:  0,             // potted_crimson_fungus
+	881:  0,             // potted_crimson_roots
+	392:  0,             // potted_dandelion
+	388:  0,             // potted_dark_oak_sapling
+	407:  0,             // potted_dead_bush
+	391:  0,             // potted_fern
+	1086: 0,             // potted_flowering_azalea_bush
+	385:  0,             // potted_jungle_sapling
+	403:  0,             // potted_lily_of_the_valley
+	390:  0,             // potted_mangrove_propagule
+	382:  0,             // potted_oak_sapling
+	1102: 0,             // potted_open_eyeblossom
+	398:  0,             // potted_orange_tulip
+	401:  0,             // potted_oxeye_daisy
+	389:  0,             // potted_pale_oak_sapling
+	400:  0,             // potted_pink_tulip
+	393:  0,             // potted_poppy
+	405:  0,             // potted_red_mushroom
+	397:  0,             // potted_red_tulip
+	383:  0,             // potted_spruce_sapling
+	381:  0,             // potted_torchflower
+	880:  0,             // potted_warped_fungus
+	882:  0,             // potted_warped_roots
+	399:  0,             // potted_white_tulip
+	404:  0,             // potted_wither_rose
+	960:  0.2,           // powder_snow
+	359:  2,             // powder_snow_cauldron
+	126:  0.7,           // powered_rail
+	495:  6,             // prismarine
+	502:  6,             // prismarine_brick_slab
+	499:  6,             // prismarine_brick_stairs
+	496:  6,             // prismarine_bricks
+	501:  6,             // prismarine_slab
+	498:  6,             // prismarine_stairs
+	793:  6,             // prismarine_wall
+	329:  1,             // pumpkin
+	333:  0,             // pumpkin_stem
+	541:  1,             // purple_banner
+	120:  0.2,           // purple_bed
+	915:  0.1,           // purple_candle
+	932:  0.5,           // purple_candle_cake
+	516:  0.1,           // purple_carpet
+	688:  1.8,           // purple_concrete
+	704:  0.5,           // purple_concrete_powder
+	672:  1.4,           // purple_glazed_terracotta
+	656:  2,             // purple_shulker_box
+	295:  0.3,           // purple_stained_glass
+	478:  0.3,           // purple_stained_glass_pane
+	462:  4.2,           // purple_terracotta
+	557:  1,             // purple_wall_banner
+	150:  0.8,           // purple_wool
+	626:  6,             // purpur_block
+	627:  6,             // purpur_pillar
+	591:  6,             // purpur_slab
+	628:  6,             // purpur_stairs
+	446:  0.8,           // quartz_block
+	903:  0.8,           // quartz_bricks
+	448:  0.8,           // quartz_pillar
+	588:  2,             // quartz_slab
+	449:  0.8,           // quartz_stairs
+	209:  0.7,           // rail
+	1083: 6,             // raw_copper_block
+	1084: 6,             // raw_gold_block
+	1082: 6,             // raw_iron_block
+	545:  1,             // red_banner
+	124:  0.2,           // red_bed
+	919:  0.1,           // red_candle
+	936:  0.5,           // red_candle_cake
+	520:  0.1,           // red_carpet
+	692:  1.8,           // red_concrete
+	708:  0.5,           // red_concrete_powder
+	676:  1.4,           // red_glazed_terracotta
+	172:  0,             // red_mushroom
+	324:  0.2,           // red_mushroom_block
+	789:  6,             // red_nether_brick_slab
+	776:  6,             // red_nether_brick_stairs
+	801:  6,             // red_nether_brick_wall
+	641:  6,             // red_nether_bricks
+	39:   0.5,           // red_sand
+	563:  0.8,           // red_sandstone
+	589:  2,             // red_sandstone_slab
+	566:  0.8,           // red_sandstone_stairs
+	794:  0.8,           // red_sandstone_wall
+	660:  2,             // red_shulker_box
+	299:  0.3,           // red_stained_glass
+	482:  0.3,           // red_stained_glass_pane
+	466:  4.2,           // red_terracotta
+	163:  0,             // red_tulip
+	561:  1,             // red_wall_banner
+	154:  0.8,           // red_wool
+	443:  6,             // redstone_block
+	364:  0.3,           // redstone_lamp
+	258:  3,             // redstone_ore
+	260:  0,             // redstone_torch
+	261:  0,             // redstone_wall_torch
+	189:  0,             // redstone_wire
+	1091: 1200,          // reinforced_deepslate
+	284:  0,             // repeater
+	636:  3.6e+06,       // repeating_command_block
+	344:  0,             // resin_block
+	347:  6,             // resin_brick_slab
+	346:  6,             // resin_brick_stairs
+	348:  6,             // resin_brick_wall
+	345:  6,             // resin_bricks
+	337:  0,             // resin_clump
+	878:  1200,          // respawn_anchor
+	1058: 0.5,           // rooted_dirt
+	527:  0,             // rose_bush
+	37:   0.5,           // sand
+	106:  0.8,           // sandstone
+	580:  2,             // sandstone_slab
+	366:  0.8,           // sandstone_stairs
+	802:  0.8,           // sandstone_wall
+	805:  0,             // scaffolding
+	963:  0.2,           // sculk
+	965:  3,             // sculk_catalyst
+	961:  1.5,           // sculk_sensor
+	966:  3,             // sculk_shrieker
+	964:  0.2,           // sculk_vein
+	504:  0.3,           // sea_lantern
+	756:  0,             // sea_pickle
+	136:  0,             // seagrass
+	134:  0,             // short_dry_grass
+	130:  0,             // short_grass
+	837:  1,             // shroomlight
+	645:  2,             // shulker_box
+	421:  1,             // skeleton_skull
+	422:  1,             // skeleton_wall_skull
+	491:  0,             // slime_block
+	943:  1.5,           // small_amethyst_bud
+	1056: 0,             // small_dripleaf
+	814:  2.5,           // smithing_table
+	808:  3.5,           // smoker
+	1081: 4.2,           // smooth_basalt
+	594:  2,             // smooth_quartz
+	786:  2,             // smooth_quartz_slab
+	773:  2,             // smooth_quartz_stairs
+	595:  2,             // smooth_red_sandstone
+	780:  2,             // smooth_red_sandstone_slab
+	766:  2,             // smooth_red_sandstone_stairs
+	593:  2,             // smooth_sandstone
+	785:  2,             // smooth_sandstone_slab
+	772:  2,             // smooth_sandstone_stairs
+	592:  6,             // smooth_stone
+	579:  6,             // smooth_stone_slab
+	714:  0.5,           // sniffer_egg
+	263:  0.1,           // snow
+	265:  0.2,           // snow_block
+	820:  2,             // soul_campfire
+	184:  0,             // soul_fire
+	818:  3.5,           // soul_lantern
+	273:  0.5,           // soul_sand
+	274:  0.5,           // soul_soil
+	277:  0,             // soul_torch
+	278:  0,             // soul_wall_torch
+	185:  5,             // spawner
+	99:   0.6,           // sponge
+	1046: 0,             // spore_blossom
+	412:  0.5,           // spruce_button
+	614:  3,             // spruce_door
+	605:  3,             // spruce_fence
+	596:  3,             // spruce_fence_gate
+	222:  1,             // spruce_hanging_sign
+	89:   0.2,           // spruce_leaves
+	50:   2,             // spruce_log
+	14:   3,             // spruce_planks
+	249:  0.5,           // spruce_pressure_plate
+	26:   0,             // spruce_sapling
+	198:  1,             // spruce_sign
+	568:  3,             // spruce_slab
+	373:  3,             // spruce_stairs
+	302:  3,             // spruce_trapdoor
+	234:  1,             // spruce_wall_hanging_sign
+	212:  1,             // spruce_wall_sign
+	72:   2,             // spruce_wood
+	128:  1.5,           // sticky_piston
+	1:    6,             // stone
+	585:  6,             // stone_brick_slab
+	340:  6,             // stone_brick_stairs
+	797:  6,             // stone_brick_wall
+	311:  6,             // stone_bricks
+	262:  0.5,           // stone_button
+	246:  0.5,           // stone_pressure_plate
+	578:  6,             // stone_slab
+	771:  6,             // stone_stairs
+	815:  3.5,           // stonecutter
+	64:   2,             // stripped_acacia_log
+	83:   2,             // stripped_acacia_wood
+	70:   2,             // stripped_bamboo_block
+	62:   2,             // stripped_birch_log
+	81:   2,             // stripped_birch_wood
+	65:   2,             // stripped_cherry_log
+	84:   2,             // stripped_cherry_wood
+	834:  2,             // stripped_crimson_hyphae
+	832:  2,             // stripped_crimson_stem
+	66:   2,             // stripped_dark_oak_log
+	85:   2,             // stripped_dark_oak_wood
+	63:   2,             // stripped_jungle_log
+	82:   2,             // stripped_jungle_wood
+	69:   2,             // stripped_mangrove_log
+	87:   2,             // stripped_mangrove_wood
+	68:   2,             // stripped_oak_log
+	79:   2,             // stripped_oak_wood
+	67:   2,             // stripped_pale_oak_log
+	86:   2,             // stripped_pale_oak_wood
+	61:   2,             // stripped_spruce_log
+	80:   2,             // stripped_spruce_wood
+	825:  2,             // stripped_warped_hyphae
+	823:  2,             // stripped_warped_stem
+	865:  3.6e+06,       // structure_block
+	643:  0,             // structure_void
+	269:  0,             // sugar_cane
+	525:  0,             // sunflower
+	41:   0.2,           // suspicious_gravel
+	38:   0.2,           // suspicious_sand
+	821:  0,             // sweet_berry_bush
+	135:  0,             // tall_dry_grass
+	529:  0,             // tall_grass
+	137:  0,             // tall_seagrass
+	870:  0.5,           // target
+	522:  4.2,           // terracotta
+	867:  3.6e+06,       // test_block
+	868:  3.6e+06,       // test_instance_block
+	959:  0.3,           // tinted_glass
+	176:  0,             // tnt
+	181:  0,             // torch
+	158:  0,             // torchflower
+	630:  0,             // torchflower_crop
+	438:  2.5,           // trapped_chest
+	1094: 50,            // trial_spawner
+	371:  0,             // tripwire
+	370:  0,             // tripwire_hook
+	731:  0,             // tube_coral
+	721:  1.5,           // tube_coral_block
+	741:  0,             // tube_coral_fan
+	751:  0,             // tube_coral_wall_fan
+	944:  6,             // tuff
+	954:  6,             // tuff_brick_slab
+	955:  6,             // tuff_brick_stairs
+	956:  6,             // tuff_brick_wall
+	953:  6,             // tuff_bricks
+	945:  6,             // tuff_slab
+	946:  6,             // tuff_stairs
+	947:  6,             // tuff_wall
+	713:  0.5,           // turtle_egg
+	840:  0,             // twisting_vines
+	841:  0,             // twisting_vines_plant
+	1095: 50,            // vault
+	1088: 0.3,           // verdant_froglight
+	335:  0.2,           // vine
+	762:  0,             // void_air
+	182:  0,             // wall_torch
+	858:  0.5,           // warped_button
+	860:  3,             // warped_door
+	850:  3,             // warped_fence
+	854:  3,             // warped_fence_gate
+	827:  0,             // warped_fungus
+	230:  1,             // warped_hanging_sign
+	824:  2,             // warped_hyphae
+	826:  0.4,           // warped_nylium
+	844:  3,             // warped_planks
+	848:  0.5,           // warped_pressure_plate
+	829:  0,             // warped_roots
+	862:  1,             // warped_sign
+	846:  3,             // warped_slab
+	856:  3,             // warped_stairs
+	822:  2,             // warped_stem
+	852:  3,             // warped_trapdoor
+	243:  1,             // warped_wall_hanging_sign
+	864:  1,             // warped_wall_sign
+	828:  1,             // warped_wart_block
+	35:   100,           // water
+	357:  2,             // water_cauldron
+	984:  6,             // waxed_chiseled_copper
+	993:  6,             // waxed_copper_block
+	1037: 6,             // waxed_copper_bulb
+	1013: 6,             // waxed_copper_door
+	1029: 6,             // waxed_copper_grate
+	1021: 6,             // waxed_copper_trapdoor
+	1000: 6,             // waxed_cut_copper
+	1008: 6,             // waxed_cut_copper_slab
+	1004: 6,             // waxed_cut_copper_stairs
+	983:  6,             // waxed_exposed_chiseled_copper
+	995:  6,             // waxed_exposed_copper
+	1038: 6,             // waxed_exposed_copper_bulb
+	1014: 6,             // waxed_exposed_copper_door
+	1030: 6,             // waxed_exposed_copper_grate
+	1022: 6,             // waxed_exposed_copper_trapdoor
+	999:  6,             // waxed_exposed_cut_copper
+	1007: 6,             // waxed_exposed_cut_copper_slab
+	1003: 6,             // waxed_exposed_cut_copper_stairs
+	981:  6,             // waxed_oxidized_chiseled_copper
+	996:  6,             // waxed_oxidized_copper
+	1040: 6,             // waxed_oxidized_copper_bulb
+	1015: 6,             // waxed_oxidized_copper_door
+	1032: 6,             // waxed_oxidized_copper_grate
+	1023: 6,             // waxed_oxidized_copper_trapdoor
+	997:  6,             // waxed_oxidized_cut_copper
+	1005: 6,             // waxed_oxidized_cut_copper_slab
+	1001: 6,             // waxed_oxidized_cut_copper_stairs
+	982:  6,             // waxed_weathered_chiseled_copper
+	994:  6,             // waxed_weathered_copper
+	1039: 6,             // waxed_weathered_copper_bulb
+	1016: 6,             // waxed_weathered_copper_door
+	1031: 6,             // waxed_weathered_copper_grate
+	1024: 6,             // waxed_weathered_copper_trapdoor
+	998:  6,             // waxed_weathered_cut_copper
+	1006: 6,             // waxed_weathered_cut_copper_slab
+	1002: 6,             // waxed_weathered_cut_copper_stairs
+	978:  6,             // weathered_chiseled_copper
+	969:  6,             // weathered_copper
+	1035: 6,             // weathered_copper_bulb
+	1012: 6,             // weathered_copper_door
+	1027: 6,             // weathered_copper_grate
+	1020: 6,             // weathered_copper_trapdoor
+	974:  6,             // weathered_cut_copper
+	990:  6,             // weathered_cut_copper_slab
+	986:  6,             // weathered_cut_copper_stairs
+	838:  0,             // weeping_vines
+	839:  0,             // weeping_vines_plant
+	100:  0.6,           // wet_sponge
+	194:  0,             // wheat
+	531:  1,             // white_banner
+	110:  0.2,           // white_bed
+	905:  0.1,           // white_candle
+	922:  0.5,           // white_candle_cake
+	506:  0.1,           // white_carpet
+	678:  1.8,           // white_concrete
+	694:  0.5,           // white_concrete_powder
+	662:  1.4,           // white_glazed_terracotta
+	646:  2,             // white_shulker_box
+	285:  0.3,           // white_stained_glass
+	468:  0.3,           // white_stained_glass_pane
+	452:  4.2,           // white_terracotta
+	165:  0,             // white_tulip
+	547:  1,             // white_wall_banner
+	140:  0.8,           // white_wool
+	1051: 0,             // wildflowers
+	169:  0,             // wither_rose
+	423:  1,             // wither_skeleton_skull
+	424:  1,             // wither_skeleton_wall_skull
+	535:  1,             // yellow_banner
+	114:  0.2,           // yellow_bed
+	909:  0.1,           // yellow_candle
+	926:  0.5,           // yellow_candle_cake
+	510:  0.1,           // yellow_carpet
+	682:  1.8,           // yellow_concrete
+	698:  0.5,           // yellow_concrete_powder
+	666:  1.4,           // yellow_glazed_terracotta
+	650:  2,             // yellow_shulker_box
+	289:  0.3,           // yellow_stained_glass
+	472:  0.3,           // yellow_stained_glass_pane
+	456:  4.2,           // yellow_terracotta
+	551:  1,             // yellow_wall_banner
+	144:  0.8,           // yellow_wool
+	425:  1,             // zombie_head
+	426:  1,             // zombie_wall_head
+}
+
+// MapColorMap stores the map color ID of each block (Minecraft 1.21.10)
+var MapColorMap = map[int]int{
+	617:  15, // acacia_door
+	608:  15, // acacia_fence
+	599:  15, // acacia_fence_gate
+	224:  15, // acacia_hanging_sign
+	92:   7,  // acacia_leaves
+	53:   15, // acacia_log
+	17:   15, // acacia_planks
+	252:  15, // acacia_pressure_plate
+	29:   7,  // acacia_sapling
+	200:  15, // acacia_sign
+	571:  15, // acacia_slab
+	484:  15, // acacia_stairs
+	305:  15, // acacia_trapdoor
+	236:  15, // acacia_wall_hanging_sign
+	214:  15, // acacia_wall_sign
+	75:   21, // acacia_wood
+	161:  7,  // allium
+	938:  24, // amethyst_block
+	940:  24, // amethyst_cluster
+	876:  29, // ancient_debris
+	6:    11, // andesite
+	788:  11, // andesite_slab
+	775:  11, // andesite_stairs
+	800:  11, // andesite_wall
+	435:  6,  // anvil
+	332:  7,  // attached_melon_stem
+	331:  7,  // attached_pumpkin_stem
+	1047: 7,  // azalea
+	97:   7,  // azalea_leaves
+	162:  7,  // azure_bluet
+	760:  7,  // bamboo
+	60:   18, // bamboo_block
+	622:  18, // bamboo_door
+	613:  18, // bamboo_fence
+	604:  18, // bamboo_fence_gate
+	232:  18, // bamboo_hanging_sign
+	24:   18, // bamboo_mosaic
+	577:  18, // bamboo_mosaic_slab
+	490:  18, // bamboo_mosaic_stairs
+	23:   18, // bamboo_planks
+	257:  18, // bamboo_pressure_plate
+	759:  13, // bamboo_sapling
+	206:  18, // bamboo_sign
+	576:  18, // bamboo_slab
+	489:  18, // bamboo_stairs
+	310:  18, // bamboo_trapdoor
+	244:  18, // bamboo_wall_hanging_sign
+	220:  18, // bamboo_wall_sign
+	807:  13, // barrel
+	275:  29, // basalt
+	377:  31, // beacon
+	34:   11, // bedrock
+	871:  18, // bee_nest
+	872:  13, // beehive
+	633:  7,  // beetroots
+	816:  30, // bell
+	1054: 7,  // big_dripleaf
+	1055: 7,  // big_dripleaf_stem
+	615:  2,  // birch_door
+	606:  2,  // birch_fence
+	597:  2,  // birch_fence_gate
+	223:  2,  // birch_hanging_sign
+	90:   7,  // birch_leaves
+	51:   2,  // birch_log
+	15:   2,  // birch_planks
+	250:  2,  // birch_pressure_plate
+	27:   7,  // birch_sapling
+	199:  2,  // birch_sign
+	569:  2,  // birch_slab
+	374:  2,  // birch_stairs
+	303:  2,  // birch_trapdoor
+	235:  2,  // birch_wall_hanging_sign
+	213:  2,  // birch_wall_sign
+	73:   14, // birch_wood
+	125:  3,  // black_bed
+	920:  29, // black_candle
+	521:  29, // black_carpet
+	693:  29, // black_concrete
+	709:  29, // black_concrete_powder
+	677:  29, // black_glazed_terracotta
+	661:  29, // black_shulker_box
+	300:  29, // black_stained_glass
+	483:  29, // black_stained_glass_pane
+	467:  51, // black_terracotta
+	155:  29, // black_wool
+	884:  29, // blackstone
+	887:  29, // blackstone_slab
+	885:  29, // blackstone_stairs
+	886:  29, // blackstone_wall
+	809:  11, // blast_furnace
+	121:  3,  // blue_bed
+	916:  25, // blue_candle
+	517:  25, // blue_carpet
+	689:  25, // blue_concrete
+	705:  25, // blue_concrete_powder
+	673:  25, // blue_glazed_terracotta
+	757:  5,  // blue_ice
+	160:  7,  // blue_orchid
+	657:  25, // blue_shulker_box
+	296:  25, // blue_stained_glass
+	479:  25, // blue_stained_glass_pane
+	463:  47, // blue_terracotta
+	151:  25, // blue_wool
+	642:  2,  // bone_block
+	177:  13, // bookshelf
+	732:  20, // brain_coral
+	722:  20, // brain_coral_block
+	742:  20, // brain_coral_fan
+	752:  20, // brain_coral_wall_fan
+	355:  6,  // brewing_stand
+	584:  28, // brick_slab
+	339:  28, // brick_stairs
+	792:  28, // brick_wall
+	175:  28, // bricks
+	122:  3,  // brown_bed
+	917:  26, // brown_candle
+	518:  26, // brown_carpet
+	690:  26, // brown_concrete
+	706:  26, // brown_concrete_powder
+	674:  26, // brown_glazed_terracotta
+	171:  26, // brown_mushroom
+	323:  10, // brown_mushroom_block
+	658:  26, // brown_shulker_box
+	297:  26, // brown_stained_glass
+	480:  26, // brown_stained_glass_pane
+	464:  48, // brown_terracotta
+	152:  26, // brown_wool
+	764:  12, // bubble_column
+	733:  24, // bubble_coral
+	723:  24, // bubble_coral_block
+	743:  24, // bubble_coral_fan
+	753:  24, // bubble_coral_wall_fan
+	939:  24, // budding_amethyst
+	133:  7,  // bush
+	266:  7,  // cactus
+	267:  7,  // cactus_flower
+	958:  36, // calcite
+	962:  23, // calibrated_sculk_sensor
+	819:  34, // campfire
+	904:  2,  // candle
+	409:  7,  // carrots
+	810:  13, // cartography_table
+	281:  15, // carved_pumpkin
+	356:  11, // cauldron
+	1044: 7,  // cave_vines
+	1045: 7,  // cave_vines_plant
+	637:  27, // chain_command_block
+	618:  36, // cherry_door
+	609:  36, // cherry_fence
+	600:  36, // cherry_fence_gate
+	225:  36, // cherry_hanging_sign
+	93:   20, // cherry_leaves
+	54:   36, // cherry_log
+	18:   36, // cherry_planks
+	253:  36, // cherry_pressure_plate
+	30:   7,  // cherry_sapling
+	201:  36, // cherry_sign
+	572:  36, // cherry_slab
+	485:  36, // cherry_stairs
+	306:  36, // cherry_trapdoor
+	237:  36, // cherry_wall_hanging_sign
+	215:  36, // cherry_wall_sign
+	76:   51, // cherry_wood
+	188:  13, // chest
+	436:  6,  // chipped_anvil
+	178:  13, // chiseled_bookshelf
+	980:  15, // chiseled_copper
+	1077: 59, // chiseled_deepslate
+	901:  35, // chiseled_nether_bricks
+	891:  29, // chiseled_polished_blackstone
+	447:  14, // chiseled_quartz_block
+	564:  15, // chiseled_red_sandstone
+	349:  37, // chiseled_resin_bricks
+	107:  2,  // chiseled_sandstone
+	314:  11, // chiseled_stone_bricks
+	952:  43, // chiseled_tuff
+	957:  43, // chiseled_tuff_bricks
+	625:  24, // chorus_flower
+	624:  24, // chorus_plant
+	268:  9,  // clay
+	1101: 7,  // closed_eyeblossom
+	523:  29, // coal_block
+	46:   11, // coal_ore
+	10:   10, // coarse_dirt
+	1061: 59, // cobbled_deepslate
+	1063: 59, // cobbled_deepslate_slab
+	1062: 59, // cobbled_deepslate_stairs
+	1064: 59, // cobbled_deepslate_wall
+	12:   11, // cobblestone
+	583:  11, // cobblestone_slab
+	210:  11, // cobblestone_stairs
+	378:  11, // cobblestone_wall
+	129:  3,  // cobweb
+	365:  7,  // cocoa
+	376:  26, // command_block
+	869:  13, // composter
+	758:  31, // conduit
+	967:  15, // copper_block
+	1033: 15, // copper_bulb
+	1009: 15, // copper_door
+	1025: 15, // copper_grate
+	971:  15, // copper_ore
+	1017: 15, // copper_trapdoor
+	168:  7,  // cornflower
+	1078: 59, // cracked_deepslate_bricks
+	1079: 59, // cracked_deepslate_tiles
+	902:  35, // cracked_nether_bricks
+	890:  29, // cracked_polished_blackstone_bricks
+	313:  11, // cracked_stone_bricks
+	1093: 11, // crafter
+	193:  13, // crafting_table
+	186:  15, // creaking_heart
+	859:  53, // crimson_door
+	849:  53, // crimson_fence
+	853:  53, // crimson_fence_gate
+	836:  28, // crimson_fungus
+	229:  53, // crimson_hanging_sign
+	833:  54, // crimson_hyphae
+	835:  52, // crimson_nylium
+	843:  53, // crimson_planks
+	847:  53, // crimson_pressure_plate
+	842:  28, // crimson_roots
+	861:  53, // crimson_sign
+	845:  53, // crimson_slab
+	855:  53, // crimson_stairs
+	831:  53, // crimson_stem
+	851:  53, // crimson_trapdoor
+	242:  53, // crimson_wall_hanging_sign
+	863:  53, // crimson_wall_sign
+	877:  29, // crying_obsidian
+	976:  15, // cut_copper
+	992:  15, // cut_copper_slab
+	988:  15, // cut_copper_stairs
+	565:  15, // cut_red_sandstone
+	590:  15, // cut_red_sandstone_slab
+	108:  2,  // cut_sandstone
+	581:  2,  // cut_sandstone_slab
+	119:  3,  // cyan_bed
+	914:  23, // cyan_candle
+	515:  23, // cyan_carpet
+	687:  23, // cyan_concrete
+	703:  23, // cyan_concrete_powder
+	671:  23, // cyan_glazed_terracotta
+	655:  23, // cyan_shulker_box
+	294:  23, // cyan_stained_glass
+	477:  23, // cyan_stained_glass_pane
+	461:  45, // cyan_terracotta
+	149:  23, // cyan_wool
+	437:  6,  // damaged_anvil
+	157:  7,  // dandelion
+	619:  26, // dark_oak_door
+	610:  26, // dark_oak_fence
+	601:  26, // dark_oak_fence_gate
+	227:  26, // dark_oak_hanging_sign
+	94:   7,  // dark_oak_leaves
+	55:   26, // dark_oak_log
+	19:   26, // dark_oak_planks
+	254:  26, // dark_oak_pressure_plate
+	31:   7,  // dark_oak_sapling
+	203:  26, // dark_oak_sign
+	573:  26, // dark_oak_slab
+	486:  26, // dark_oak_stairs
+	307:  26, // dark_oak_trapdoor
+	239:  26, // dark_oak_wall_hanging_sign
+	217:  26, // dark_oak_wall_sign
+	77:   26, // dark_oak_wood
+	497:  31, // dark_prismarine
+	503:  31, // dark_prismarine_slab
+	500:  31, // dark_prismarine_stairs
+	442:  13, // daylight_detector
+	727:  21, // dead_brain_coral
+	717:  21, // dead_brain_coral_block
+	737:  21, // dead_brain_coral_fan
+	747:  21, // dead_brain_coral_wall_fan
+	728:  21, // dead_bubble_coral
+	718:  21, // dead_bubble_coral_block
+	738:  21, // dead_bubble_coral_fan
+	748:  21, // dead_bubble_coral_wall_fan
+	132:  7,  // dead_bush
+	729:  21, // dead_fire_coral
+	719:  21, // dead_fire_coral_block
+	739:  21, // dead_fire_coral_fan
+	749:  21, // dead_fire_coral_wall_fan
+	730:  21, // dead_horn_coral
+	720:  21, // dead_horn_coral_block
+	740:  21, // dead_horn_coral_fan
+	750:  21, // dead_horn_coral_wall_fan
+	726:  21, // dead_tube_coral
+	716:  21, // dead_tube_coral_block
+	736:  21, // dead_tube_coral_fan
+	746:  21, // dead_tube_coral_wall_fan
+	1092: 50, // decorated_pot
+	1060: 59, // deepslate
+	1075: 59, // deepslate_brick_slab
+	1074: 59, // deepslate_brick_stairs
+	1076: 59, // deepslate_brick_wall
+	1073: 59, // deepslate_bricks
+	47:   59, // deepslate_coal_ore
+	972:  15, // deepslate_copper_ore
+	191:  59, // deepslate_diamond_ore
+	368:  59, // deepslate_emerald_ore
+	43:   59, // deepslate_gold_ore
+	45:   59, // deepslate_iron_ore
+	103:  59, // deepslate_lapis_ore
+	259:  59, // deepslate_redstone_ore
+	1071: 59, // deepslate_tile_slab
+	1070: 59, // deepslate_tile_stairs
+	1072: 59, // deepslate_tile_wall
+	1069: 59, // deepslate_tiles
+	192:  31, // diamond_block
+	190:  11, // diamond_ore
+	4:    14, // diorite
+	791:  14, // diorite_slab
+	778:  14, // diorite_stairs
+	804:  14, // diorite_wall
+	9:    10, // dirt
+	634:  10, // dirt_path
+	105:  11, // dispenser
+	363:  29, // dragon_egg
+	715:  22, // dried_ghast
+	712:  27, // dried_kelp_block
+	1043: 48, // dripstone_block
+	451:  11, // dropper
+	372:  33, // emerald_block
+	367:  11, // emerald_ore
+	354:  28, // enchanting_table
+	635:  29, // end_gateway
+	360:  29, // end_portal
+	361:  33, // end_portal_frame
+	362:  2,  // end_stone
+	784:  2,  // end_stone_brick_slab
+	770:  2,  // end_stone_brick_stairs
+	803:  2,  // end_stone_brick_wall
+	629:  2,  // end_stone_bricks
+	369:  11, // ender_chest
+	979:  44, // exposed_chiseled_copper
+	968:  44, // exposed_copper
+	1034: 44, // exposed_copper_bulb
+	1010: 44, // exposed_copper_door
+	1026: 44, // exposed_copper_grate
+	1018: 44, // exposed_copper_trapdoor
+	975:  44, // exposed_cut_copper
+	991:  44, // exposed_cut_copper_slab
+	987:  44, // exposed_cut_copper_stairs
+	195:  10, // farmland
+	131:  7,  // fern
+	183:  4,  // fire
+	734:  28, // fire_coral
+	724:  28, // fire_coral_block
+	744:  28, // fire_coral_fan
+	754:  28, // fire_coral_wall_fan
+	1104: 7,  // firefly_bush
+	811:  13, // fletching_table
+	1048: 7,  // flowering_azalea
+	98:   7,  // flowering_azalea_leaves
+	638:  5,  // frosted_ice
+	196:  11, // furnace
+	895:  29, // gilded_blackstone
+	336:  61, // glow_lichen
+	279:  2,  // glowstone
+	173:  30, // gold_block
+	42:   11, // gold_ore
+	2:    10, // granite
+	787:  10, // granite_slab
+	774:  10, // granite_stairs
+	796:  10, // granite_wall
+	8:    1,  // grass_block
+	40:   11, // gravel
+	117:  3,  // gray_bed
+	912:  21, // gray_candle
+	513:  21, // gray_carpet
+	685:  21, // gray_concrete
+	701:  21, // gray_concrete_powder
+	669:  21, // gray_glazed_terracotta
+	653:  21, // gray_shulker_box
+	292:  21, // gray_stained_glass
+	475:  21, // gray_stained_glass_pane
+	459:  43, // gray_terracotta
+	147:  21, // gray_wool
+	123:  3,  // green_bed
+	918:  27, // green_candle
+	519:  27, // green_carpet
+	691:  27, // green_concrete
+	707:  27, // green_concrete_powder
+	675:  27, // green_glazed_terracotta
+	659:  27, // green_shulker_box
+	298:  27, // green_stained_glass
+	481:  27, // green_stained_glass_pane
+	465:  49, // green_terracotta
+	153:  27, // green_wool
+	812:  6,  // grindstone
+	1057: 10, // hanging_roots
+	505:  18, // hay_block
+	1096: 6,  // heavy_core
+	440:  6,  // heavy_weighted_pressure_plate
+	873:  15, // honey_block
+	874:  15, // honeycomb_block
+	445:  11, // hopper
+	735:  18, // horn_coral
+	725:  18, // horn_coral_block
+	745:  18, // horn_coral_fan
+	755:  18, // horn_coral_wall_fan
+	264:  5,  // ice
+	322:  11, // infested_chiseled_stone_bricks
+	318:  11, // infested_cobblestone
+	321:  11, // infested_cracked_stone_bricks
+	1080: 59, // infested_deepslate
+	320:  11, // infested_mossy_stone_bricks
+	317:  11, // infested_stone
+	319:  11, // infested_stone_bricks
+	174:  6,  // iron_block
+	247:  6,  // iron_door
+	44:   11, // iron_ore
+	494:  6,  // iron_trapdoor
+	282:  15, // jack_o_lantern
+	866:  22, // jigsaw
+	270:  10, // jukebox
+	616:  10, // jungle_door
+	607:  10, // jungle_fence
+	598:  10, // jungle_fence_gate
+	226:  10, // jungle_hanging_sign
+	91:   7,  // jungle_leaves
+	52:   10, // jungle_log
+	16:   10, // jungle_planks
+	251:  10, // jungle_pressure_plate
+	28:   7,  // jungle_sapling
+	202:  10, // jungle_sign
+	570:  10, // jungle_slab
+	375:  10, // jungle_stairs
+	304:  10, // jungle_trapdoor
+	238:  10, // jungle_wall_hanging_sign
+	216:  10, // jungle_wall_sign
+	74:   34, // jungle_wood
+	710:  12, // kelp
+	711:  12, // kelp_plant
+	817:  6,  // lantern
+	104:  32, // lapis_block
+	102:  11, // lapis_ore
+	941:  24, // large_amethyst_bud
+	530:  7,  // large_fern
+	36:   4,  // lava
+	358:  11, // lava_cauldron
+	813:  13, // lectern
+	113:  3,  // light_blue_bed
+	908:  17, // light_blue_candle
+	509:  17, // light_blue_carpet
+	681:  17, // light_blue_concrete
+	697:  17, // light_blue_concrete_powder
+	665:  17, // light_blue_glazed_terracotta
+	649:  17, // light_blue_shulker_box
+	288:  17, // light_blue_stained_glass
+	471:  17, // light_blue_stained_glass_pane
+	455:  39, // light_blue_terracotta
+	143:  17, // light_blue_wool
+	118:  3,  // light_gray_bed
+	913:  22, // light_gray_candle
+	514:  22, // light_gray_carpet
+	686:  22, // light_gray_concrete
+	702:  22, // light_gray_concrete_powder
+	670:  22, // light_gray_glazed_terracotta
+	654:  22, // light_gray_shulker_box
+	293:  22, // light_gray_stained_glass
+	476:  22, // light_gray_stained_glass_pane
+	460:  44, // light_gray_terracotta
+	148:  22, // light_gray_wool
+	439:  30, // light_weighted_pressure_plate
+	1041: 15, // lightning_rod
+	526:  7,  // lilac
+	170:  7,  // lily_of_the_valley
+	343:  7,  // lily_pad
+	115:  3,  // lime_bed
+	910:  19, // lime_candle
+	511:  19, // lime_carpet
+	683:  19, // lime_concrete
+	699:  19, // lime_concrete_powder
+	667:  19, // lime_glazed_terracotta
+	651:  19, // lime_shulker_box
+	290:  19, // lime_stained_glass
+	473:  19, // lime_stained_glass_pane
+	457:  41, // lime_terracotta
+	145:  19, // lime_wool
+	883:  6,  // lodestone
+	806:  13, // loom
+	112:  3,  // magenta_bed
+	907:  16, // magenta_candle
+	508:  16, // magenta_carpet
+	680:  16, // magenta_concrete
+	696:  16, // magenta_concrete_powder
+	664:  16, // magenta_glazed_terracotta
+	648:  16, // magenta_shulker_box
+	287:  16, // magenta_stained_glass
+	470:  16, // magenta_stained_glass_pane
+	454:  38, // magenta_terracotta
+	142:  16, // magenta_wool
+	639:  35, // magma_block
+	621:  28, // mangrove_door
+	612:  28, // mangrove_fence
+	603:  28, // mangrove_fence_gate
+	231:  28, // mangrove_hanging_sign
+	96:   7,  // mangrove_leaves
+	57:   28, // mangrove_log
+	22:   28, // mangrove_planks
+	256:  28, // mangrove_pressure_plate
+	33:   7,  // mangrove_propagule
+	58:   34, // mangrove_roots
+	205:  28, // mangrove_sign
+	575:  28, // mangrove_slab
+	488:  28, // mangrove_stairs
+	309:  28, // mangrove_trapdoor
+	241:  28, // mangrove_wall_hanging_sign
+	219:  28, // mangrove_wall_sign
+	78:   34, // mangrove_wood
+	942:  24, // medium_amethyst_bud
+	330:  19, // melon
+	334:  7,  // melon_stem
+	1053: 27, // moss_block
+	1049: 27, // moss_carpet
+	179:  11, // mossy_cobblestone
+	783:  11, // mossy_cobblestone_slab
+	769:  11, // mossy_cobblestone_stairs
+	379:  11, // mossy_cobblestone_wall
+	781:  11, // mossy_stone_brick_slab
+	767:  11, // mossy_stone_brick_stairs
+	795:  11, // mossy_stone_brick_wall
+	312:  11, // mossy_stone_bricks
+	156:  11, // moving_piston
+	1059: 45, // mud
+	586:  44, // mud_brick_slab
+	341:  44, // mud_brick_stairs
+	798:  44, // mud_brick_wall
+	316:  44, // mud_bricks
+	59:   34, // muddy_mangrove_roots
+	325:  3,  // mushroom_stem
+	342:  24, // mycelium
+	351:  35, // nether_brick_fence
+	587:  35, // nether_brick_slab
+	352:  35, // nether_brick_stairs
+	799:  35, // nether_brick_wall
+	350:  35, // nether_bricks
+	48:   35, // nether_gold_ore
+	444:  35, // nether_quartz_ore
+	830:  23, // nether_sprouts
+	353:  28, // nether_wart
+	640:  28, // nether_wart_block
+	875:  29, // netherite_block
+	272:  35, // netherrack
+	109:  13, // note_block
+	207:  13, // oak_door
+	271:  13, // oak_fence
+	338:  13, // oak_fence_gate
+	221:  13, // oak_hanging_sign
+	88:   7,  // oak_leaves
+	49:   13, // oak_log
+	13:   13, // oak_planks
+	248:  13, // oak_pressure_plate
+	25:   7,  // oak_sapling
+	197:  13, // oak_sign
+	567:  13, // oak_slab
+	187:  13, // oak_stairs
+	301:  13, // oak_trapdoor
+	233:  13, // oak_wall_hanging_sign
+	211:  13, // oak_wall_sign
+	71:   34, // oak_wood
+	644:  11, // observer
+	180:  29, // obsidian
+	1087: 2,  // ochre_froglight
+	1100: 7,  // open_eyeblossom
+	111:  3,  // orange_bed
+	906:  15, // orange_candle
+	507:  15, // orange_carpet
+	679:  15, // orange_concrete
+	695:  15, // orange_concrete_powder
+	663:  15, // orange_glazed_terracotta
+	647:  15, // orange_shulker_box
+	286:  15, // orange_stained_glass
+	469:  15, // orange_stained_glass_pane
+	453:  37, // orange_terracotta
+	164:  7,  // orange_tulip
+	141:  15, // orange_wool
+	167:  7,  // oxeye_daisy
+	977:  55, // oxidized_chiseled_copper
+	970:  55, // oxidized_copper
+	1036: 55, // oxidized_copper_bulb
+	1011: 55, // oxidized_copper_door
+	1028: 55, // oxidized_copper_grate
+	1019: 55, // oxidized_copper_trapdoor
+	973:  55, // oxidized_cut_copper
+	989:  55, // oxidized_cut_copper_slab
+	985:  55, // oxidized_cut_copper_stairs
+	524:  5,  // packed_ice
+	315:  10, // packed_mud
+	1099: 22, // pale_hanging_moss
+	1097: 22, // pale_moss_block
+	1098: 22, // pale_moss_carpet
+	620:  14, // pale_oak_door
+	611:  14, // pale_oak_fence
+	602:  14, // pale_oak_fence_gate
+	228:  14, // pale_oak_hanging_sign
+	95:   7,  // pale_oak_leaves
+	56:   14, // pale_oak_log
+	21:   14, // pale_oak_planks
+	255:  14, // pale_oak_pressure_plate
+	32:   7,  // pale_oak_sapling
+	204:  14, // pale_oak_sign
+	574:  14, // pale_oak_slab
+	487:  14, // pale_oak_stairs
+	308:  14, // pale_oak_trapdoor
+	240:  14, // pale_oak_wall_hanging_sign
+	218:  14, // pale_oak_wall_sign
+	20:   22, // pale_oak_wood
+	1089: 20, // pearlescent_froglight
+	528:  7,  // peony
+	582:  13, // petrified_oak_slab
+	116:  3,  // pink_bed
+	911:  20, // pink_candle
+	512:  20, // pink_carpet
+	684:  20, // pink_concrete
+	700:  20, // pink_concrete_powder
+	668:  20, // pink_glazed_terracotta
+	1050: 7,  // pink_petals
+	652:  20, // pink_shulker_box
+	291:  20, // pink_stained_glass
+	474:  20, // pink_stained_glass_pane
+	458:  42, // pink_terracotta
+	166:  7,  // pink_tulip
+	146:  20, // pink_wool
+	138:  11, // piston
+	139:  11, // piston_head
+	631:  7,  // pitcher_crop
+	632:  7,  // pitcher_plant
+	11:   34, // podzol
+	1042: 48, // pointed_dripstone
+	7:    11, // polished_andesite
+	790:  11, // polished_andesite_slab
+	777:  11, // polished_andesite_stairs
+	276:  29, // polished_basalt
+	888:  29, // polished_blackstone
+	892:  29, // polished_blackstone_brick_slab
+	893:  29, // polished_blackstone_brick_stairs
+	894:  29, // polished_blackstone_brick_wall
+	889:  29, // polished_blackstone_bricks
+	899:  29, // polished_blackstone_button
+	898:  29, // polished_blackstone_pressure_plate
+	897:  29, // polished_blackstone_slab
+	896:  29, // polished_blackstone_stairs
+	900:  29, // polished_blackstone_wall
+	1065: 59, // polished_deepslate
+	1067: 59, // polished_deepslate_slab
+	1066: 59, // polished_deepslate_stairs
+	1068: 59, // polished_deepslate_wall
+	5:    14, // polished_diorite
+	782:  14, // polished_diorite_slab
+	768:  14, // polished_diorite_stairs
+	3:    10, // polished_granite
+	779:  10, // polished_granite_slab
+	765:  10, // polished_granite_stairs
+	948:  43, // polished_tuff
+	949:  43, // polished_tuff_slab
+	950:  43, // polished_tuff_stairs
+	951:  43, // polished_tuff_wall
+	159:  7,  // poppy
+	410:  7,  // potatoes
+	960:  8,  // powder_snow
+	359:  11, // powder_snow_cauldron
+	495:  23, // prismarine
+	502:  31, // prismarine_brick_slab
+	499:  31, // prismarine_brick_stairs
+	496:  31, // prismarine_bricks
+	501:  23, // prismarine_slab
+	498:  23, // prismarine_stairs
+	793:  23, // prismarine_wall
+	329:  15, // pumpkin
+	333:  7,  // pumpkin_stem
+	120:  3,  // purple_bed
+	915:  24, // purple_candle
+	516:  24, // purple_carpet
+	688:  24, // purple_concrete
+	704:  24, // purple_concrete_powder
+	672:  24, // purple_glazed_terracotta
+	656:  24, // purple_shulker_box
+	295:  24, // purple_stained_glass
+	478:  24, // purple_stained_glass_pane
+	462:  46, // purple_terracotta
+	150:  24, // purple_wool
+	626:  16, // purpur_block
+	627:  16, // purpur_pillar
+	591:  16, // purpur_slab
+	628:  16, // purpur_stairs
+	446:  14, // quartz_block
+	903:  14, // quartz_bricks
+	448:  14, // quartz_pillar
+	588:  14, // quartz_slab
+	449:  14, // quartz_stairs
+	1083: 15, // raw_copper_block
+	1084: 30, // raw_gold_block
+	1082: 60, // raw_iron_block
+	124:  3,  // red_bed
+	919:  28, // red_candle
+	520:  28, // red_carpet
+	692:  28, // red_concrete
+	708:  28, // red_concrete_powder
+	676:  28, // red_glazed_terracotta
+	172:  28, // red_mushroom
+	324:  28, // red_mushroom_block
+	789:  35, // red_nether_brick_slab
+	776:  35, // red_nether_brick_stairs
+	801:  35, // red_nether_brick_wall
+	641:  35, // red_nether_bricks
+	39:   15, // red_sand
+	563:  15, // red_sandstone
+	589:  15, // red_sandstone_slab
+	566:  15, // red_sandstone_stairs
+	794:  15, // red_sandstone_wall
+	660:  28, // red_shulker_box
+	299:  28, // red_stained_glass
+	482:  28, // red_stained_glass_pane
+	466:  50, // red_terracotta
+	163:  7,  // red_tulip
+	154:  28, // red_wool
+	443:  4,  // redstone_block
+	258:  11, // redstone_ore
+	1091: 59, // reinforced_deepslate
+	636:  24, // repeating_command_block
+	344:  37, // resin_block
+	347:  37, // resin_brick_slab
+	346:  37, // resin_brick_stairs
+	348:  37, // resin_brick_wall
+	345:  37, // resin_bricks
+	337:  37, // resin_clump
+	878:  29, // respawn_anchor
+	1058: 10, // rooted_dirt
+	527:  7,  // rose_bush
+	37:   2,  // sand
+	106:  2,  // sandstone
+	580:  2,  // sandstone_slab
+	366:  2,  // sandstone_stairs
+	802:  2,  // sandstone_wall
+	805:  2,  // scaffolding
+	963:  29, // sculk
+	965:  29, // sculk_catalyst
+	961:  23, // sculk_sensor
+	966:  29, // sculk_shrieker
+	964:  29, // sculk_vein
+	504:  14, // sea_lantern
+	756:  7,  // sea_pickle
+	136:  12, // seagrass
+	134:  7,  // short_dry_grass
+	130:  7,  // short_grass
+	837:  28, // shroomlight
+	645:  24, // shulker_box
+	491:  1,  // slime_block
+	943:  24, // small_amethyst_bud
+	1056: 7,  // small_dripleaf
+	814:  13, // smithing_table
+	808:  11, // smoker
+	1081: 29, // smooth_basalt
+	594:  14, // smooth_quartz
+	786:  14, // smooth_quartz_slab
+	773:  14, // smooth_quartz_stairs
+	595:  15, // smooth_red_sandstone
+	780:  15, // smooth_red_sandstone_slab
+	766:  15, // smooth_red_sandstone_stairs
+	593:  2,  // smooth_sandstone
+	785:  2,  // smooth_sandstone_slab
+	772:  2,  // smooth_sandstone_stairs
+	592:  11, // smooth_stone
+	579:  11, // smooth_stone_slab
+	714:  50, // sniffer_egg
+	263:  8,  // snow
+	265:  8,  // snow_block
+	820:  34, // soul_campfire
+	184:  17, // soul_fire
+	818:  6,  // soul_lantern
+	273:  26, // soul_sand
+	274:  26, // soul_soil
+	185:  11, // spawner
+	99:   18, // sponge
+	1046: 7,  // spore_blossom
+	614:  34, // spruce_door
+	605:  34, // spruce_fence
+	596:  34, // spruce_fence_gate
+	222:  34, // spruce_hanging_sign
+	89:   7,  // spruce_leaves
+	50:   34, // spruce_log
+	14:   34, // spruce_planks
+	249:  34, // spruce_pressure_plate
+	26:   7,  // spruce_sapling
+	198:  34, // spruce_sign
+	568:  34, // spruce_slab
+	373:  34, // spruce_stairs
+	302:  34, // spruce_trapdoor
+	234:  34, // spruce_wall_hanging_sign
+	212:  34, // spruce_wall_sign
+	72:   26, // spruce_wood
+	128:  11, // sticky_piston
+	1:    11, // stone
+	585:  11, // stone_brick_slab
+	340:  11, // stone_brick_stairs
+	797:  11, // stone_brick_wall
+	311:  11, // stone_bricks
+	262:  11, // stone_button
+	246:  11, // stone_pressure_plate
+	578:  11, // stone_slab
+	771:  11, // stone_stairs
+	815:  11, // stonecutter
+	64:   15, // stripped_acacia_log
+	83:   15, // stripped_acacia_wood
+	70:   18, // stripped_bamboo_block
+	62:   2,  // stripped_birch_log
+	81:   2,  // stripped_birch_wood
+	65:   36, // stripped_cherry_log
+	84:   36, // stripped_cherry_wood
+	834:  54, // stripped_crimson_hyphae
+	832:  53, // stripped_crimson_stem
+	66:   26, // stripped_dark_oak_log
+	85:   26, // stripped_dark_oak_wood
+	63:   10, // stripped_jungle_log
+	82:   10, // stripped_jungle_wood
+	69:   28, // stripped_mangrove_log
+	87:   28, // stripped_mangrove_wood
+	68:   13, // stripped_oak_log
+	79:   13, // stripped_oak_wood
+	67:   14, // stripped_pale_oak_log
+	86:   14, // stripped_pale_oak_wood
+	61:   34, // stripped_spruce_log
+	80:   34, // stripped_spruce_wood
+	825:  57, // stripped_warped_hyphae
+	823:  56, // stripped_warped_stem
+	865:  22, // structure_block
+	269:  7,  // sugar_cane
+	525:  7,  // sunflower
+	41:   11, // suspicious_gravel
+	38:   2,  // suspicious_sand
+	821:  7,  // sweet_berry_bush
+	135:  7,  // tall_dry_grass
+	529:  7,  // tall_grass
+	137:  12, // tall_seagrass
+	870:  14, // target
+	522:  15, // terracotta
+	867:  22, // test_block
+	868:  22, // test_instance_block
+	959:  21, // tinted_glass
+	176:  4,  // tnt
+	158:  7,  // torchflower
+	630:  7,  // torchflower_crop
+	438:  13, // trapped_chest
+	1094: 11, // trial_spawner
+	731:  25, // tube_coral
+	721:  25, // tube_coral_block
+	741:  25, // tube_coral_fan
+	751:  25, // tube_coral_wall_fan
+	944:  43, // tuff
+	954:  43, // tuff_brick_slab
+	955:  43, // tuff_brick_stairs
+	956:  43, // tuff_brick_wall
+	953:  43, // tuff_bricks
+	945:  43, // tuff_slab
+	946:  43, // tuff_stairs
+	947:  43, // tuff_wall
+	713:  2,  // turtle_egg
+	840:  23, // twisting_vines
+	841:  23, // twisting_vines_plant
+	1095: 11, // vault
+	1088: 61, // verdant_froglight
+	335:  7,  // vine
+	860:  56, // warped_door
+	850:  56, // warped_fence
+	854:  56, // warped_fence_gate
+	827:  23, // warped_fungus
+	230:  56, // warped_hanging_sign
+	824:  57, // warped_hyphae
+	826:  55, // warped_nylium
+	844:  56, // warped_planks
+	848:  56, // warped_pressure_plate
+	829:  23, // warped_roots
+	862:  56, // warped_sign
+	846:  56, // warped_slab
+	856:  56, // warped_stairs
+	822:  56, // warped_stem
+	852:  56, // warped_trapdoor
+	243:  56, // warped_wall_hanging_sign
+	864:  56, // warped_wall_sign
+	828:  58, // warped_wart_block
+	35:   12, // water
+	357:  11, // water_cauldron
+	984:  15, // waxed_chiseled_copper
+	993:  15, // waxed_copper_block
+	1037: 15, // waxed_copper_bulb
+	1013: 15, // waxed_copper_door
+	1029: 15, // waxed_copper_grate
+	1021: 15, // waxed_copper_trapdoor
+	1000: 15, // waxed_cut_copper
+	1008: 15, // waxed_cut_copper_slab
+	1004: 15, // waxed_cut_copper_stairs
+	983:  44, // waxed_exposed_chiseled_copper
+	995:  44, // waxed_exposed_copper
+	1038: 44, // waxed_exposed_copper_bulb
+	1014: 44, // waxed_exposed_copper_door
+	1030: 44, // waxed_exposed_copper_grate
+	1022: 44, // waxed_exposed_copper_trapdoor
+	999:  44, // waxed_exposed_cut_copper
+	1007: 44, // waxed_exposed_cut_copper_slab
+	1003: 44, // waxed_exposed_cut_copper_stairs
+	981:  55, // waxed_oxidized_chiseled_copper
+	996:  55, // waxed_oxidized_copper
+	1040: 55, // waxed_oxidized_copper_bulb
+	1015: 55, // waxed_oxidized_copper_door
+	1032: 55, // waxed_oxidized_copper_grate
+	1023: 55, // waxed_oxidized_copper_trapdoor
+	997:  55, // waxed_oxidized_cut_copper
+	1005: 55, // waxed_oxidized_cut_copper_slab
+	1001: 55, // waxed_oxidized_cut_copper_stairs
+	982:  56, // waxed_weathered_chiseled_copper
+	994:  56, // waxed_weathered_copper
+	1039: 56, // waxed_weathered_copper_bulb
+	1016: 56, // waxed_weathered_copper_door
+	1031: 56, // waxed_weathered_copper_grate
+	1024: 56, // waxed_weathered_copper_trapdoor
+	998:  56, // waxed_weathered_cut_copper
+	1006: 56, // waxed_weathered_cut_copper_slab
+	1002: 56, // waxed_weathered_cut_copper_stairs
+	978:  56, // weathered_chiseled_copper
+	969:  56, // weathered_copper
+	1035: 56, // weathered_copper_bulb
+	1012: 56, // weathered_copper_door
+	1027: 56, // weathered_copper_grate
+	1020: 56, // weathered_copper_trapdoor
+	974:  56, // weathered_cut_copper
+	990:  56, // weathered_cut_copper_slab
+	986:  56, // weathered_cut_copper_stairs
+	838:  28, // weeping_vines
+	839:  28, // weeping_vines_plant
+	100:  18, // wet_sponge
+	194:  7,  // wheat
+	110:  3,  // white_bed
+	905:  8,  // white_candle
+	506:  8,  // white_carpet
+	678:  8,  // white_concrete
+	694:  8,  // white_concrete_powder
+	662:  8,  // white_glazed_terracotta
+	646:  8,  // white_shulker_box
+	285:  8,  // white_stained_glass
+	468:  8,  // white_stained_glass_pane
+	452:  36, // white_terracotta
+	165:  7,  // white_tulip
+	140:  8,  // white_wool
+	1051: 7,  // wildflowers
+	169:  7,  // wither_rose
+	114:  3,  // yellow_bed
+	909:  18, // yellow_candle
+	510:  18, // yellow_carpet
+	682:  18, // yellow_concrete
+	698:  18, // yellow_concrete_powder
+	666:  18, // yellow_glazed_terracotta
+	650:  18, // yellow_shulker_box
+	289:  18, // yellow_stained_glass
+	472:  18, // yellow_stained_glass_pane
+	456:  40, // yellow_terracotta
+	144:  18, // yellow_wool
+}
+
 // IsSolid checks if a block ID is solid
 func IsSolid(id int) bool {
 	return IsSolidMap[id]
@@ -3098,3 +5569,18 @@ func GetHardness(id int) float64 {
 	}
 	return -1.0
 }
+
+// RequiresTool checks if a block only drops when mined with a correct tool
+func RequiresTool(id int) bool {
+	return RequiresToolMap[id]
+}
+
+// GetBlastResistance returns the blast resistance of a block
+func GetBlastResistance(id int) float64 {
+	return ResistanceMap[id]
+}
+
+// GetMapColor returns the map color ID of a block (0 if it is not drawn on maps)
+func GetMapColor(id int) int {
+	return MapColorMap[id]
+}
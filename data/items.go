@@ -1,6 +1,8 @@
 // Code generated by tools/generator.go from JSON data; DO NOT EDIT.
 package data
 
+import "github.com/konjacbot/prismarine-go/inventory"
+
 // ItemNameToID maps item names to their numeric IDs (Minecraft 1.21.10)
 var ItemNameToID = map[string]int{
 	"acacia_boat":                            835,  // acacia_boat
@@ -2913,6 +2915,842 @@ var DurabilityMap = map[int]int{
 	875:  59,   // wooden_sword
 }
 
+// FoodMap stores the food properties of edible items (Minecraft 1.21.10)
+var FoodMap = map[int]inventory.Food{
+	857:  {Nutrition: 4, Saturation: 2.4, CanAlwaysEat: false},  // apple
+	1179: {Nutrition: 5, Saturation: 6, CanAlwaysEat: false},    // baked_potato
+	1065: {Nutrition: 3, Saturation: 1.8, CanAlwaysEat: false},  // beef
+	1235: {Nutrition: 1, Saturation: 1.2, CanAlwaysEat: false},  // beetroot
+	1237: {Nutrition: 6, Saturation: 7.2, CanAlwaysEat: false},  // beetroot_soup
+	912:  {Nutrition: 5, Saturation: 6, CanAlwaysEat: false},    // bread
+	1177: {Nutrition: 3, Saturation: 3.6, CanAlwaysEat: false},  // carrot
+	1067: {Nutrition: 2, Saturation: 1.2, CanAlwaysEat: false},  // chicken
+	1231: {Nutrition: 4, Saturation: 2.4, CanAlwaysEat: true},   // chorus_fruit
+	1012: {Nutrition: 2, Saturation: 0.4, CanAlwaysEat: false},  // cod
+	1066: {Nutrition: 8, Saturation: 12.8, CanAlwaysEat: false}, // cooked_beef
+	1068: {Nutrition: 6, Saturation: 7.2, CanAlwaysEat: false},  // cooked_chicken
+	1016: {Nutrition: 5, Saturation: 6, CanAlwaysEat: false},    // cooked_cod
+	1213: {Nutrition: 6, Saturation: 9.6, CanAlwaysEat: false},  // cooked_mutton
+	939:  {Nutrition: 8, Saturation: 12.8, CanAlwaysEat: false}, // cooked_porkchop
+	1200: {Nutrition: 5, Saturation: 6, CanAlwaysEat: false},    // cooked_rabbit
+	1017: {Nutrition: 6, Saturation: 9.6, CanAlwaysEat: false},  // cooked_salmon
+	1057: {Nutrition: 2, Saturation: 0.4, CanAlwaysEat: false},  // cookie
+	1062: {Nutrition: 1, Saturation: 0.6, CanAlwaysEat: false},  // dried_kelp
+	942:  {Nutrition: 4, Saturation: 9.6, CanAlwaysEat: true},   // enchanted_golden_apple
+	1301: {Nutrition: 2, Saturation: 0.4, CanAlwaysEat: false},  // glow_berries
+	941:  {Nutrition: 4, Saturation: 9.6, CanAlwaysEat: true},   // golden_apple
+	1182: {Nutrition: 6, Saturation: 14.4, CanAlwaysEat: false}, // golden_carrot
+	1308: {Nutrition: 6, Saturation: 1.2, CanAlwaysEat: true},   // honey_bottle
+	1061: {Nutrition: 2, Saturation: 1.2, CanAlwaysEat: false},  // melon_slice
+	906:  {Nutrition: 6, Saturation: 7.2, CanAlwaysEat: false},  // mushroom_stew
+	1212: {Nutrition: 2, Saturation: 1.2, CanAlwaysEat: false},  // mutton
+	1180: {Nutrition: 2, Saturation: 1.2, CanAlwaysEat: false},  // poisonous_potato
+	938:  {Nutrition: 3, Saturation: 1.8, CanAlwaysEat: false},  // porkchop
+	1178: {Nutrition: 1, Saturation: 0.6, CanAlwaysEat: false},  // potato
+	1015: {Nutrition: 1, Saturation: 0.2, CanAlwaysEat: false},  // pufferfish
+	1191: {Nutrition: 8, Saturation: 4.8, CanAlwaysEat: false},  // pumpkin_pie
+	1199: {Nutrition: 3, Saturation: 1.8, CanAlwaysEat: false},  // rabbit
+	1201: {Nutrition: 10, Saturation: 12, CanAlwaysEat: false},  // rabbit_stew
+	1069: {Nutrition: 4, Saturation: 0.8, CanAlwaysEat: false},  // rotten_flesh
+	1013: {Nutrition: 2, Saturation: 0.4, CanAlwaysEat: false},  // salmon
+	1077: {Nutrition: 2, Saturation: 3.2, CanAlwaysEat: false},  // spider_eye
+	1275: {Nutrition: 6, Saturation: 7.2, CanAlwaysEat: true},   // suspicious_stew
+	1300: {Nutrition: 2, Saturation: 0.4, CanAlwaysEat: false},  // sweet_berries
+	1014: {Nutrition: 1, Saturation: 0.2, CanAlwaysEat: false},  // tropical_fish
+}
+
+// ToolMap stores the mining rules of tools (Minecraft 1.21.10)
+var ToolMap = map[int]*inventory.Tool{
+	898: { // diamond_axe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_diamond_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/axe"}, Speed: floatPtr(8), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	899: { // diamond_hoe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_diamond_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/hoe"}, Speed: floatPtr(8), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	897: { // diamond_pickaxe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_diamond_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/pickaxe"}, Speed: floatPtr(8), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	896: { // diamond_shovel
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_diamond_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/shovel"}, Speed: floatPtr(8), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	895: { // diamond_sword
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:sword_efficient"}, Speed: floatPtr(1.5)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+	888: { // golden_axe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_gold_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/axe"}, Speed: floatPtr(12), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	889: { // golden_hoe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_gold_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/hoe"}, Speed: floatPtr(12), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	887: { // golden_pickaxe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_gold_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/pickaxe"}, Speed: floatPtr(12), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	886: { // golden_shovel
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_gold_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/shovel"}, Speed: floatPtr(12), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	885: { // golden_sword
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:sword_efficient"}, Speed: floatPtr(1.5)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+	893: { // iron_axe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_iron_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/axe"}, Speed: floatPtr(6), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	894: { // iron_hoe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_iron_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/hoe"}, Speed: floatPtr(6), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	892: { // iron_pickaxe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_iron_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/pickaxe"}, Speed: floatPtr(6), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	891: { // iron_shovel
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_iron_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/shovel"}, Speed: floatPtr(6), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	890: { // iron_sword
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:sword_efficient"}, Speed: floatPtr(1.5)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+	1173: { // mace
+		Rules:              []inventory.ToolRule{},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+	903: { // netherite_axe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_netherite_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/axe"}, Speed: floatPtr(9), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	904: { // netherite_hoe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_netherite_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/hoe"}, Speed: floatPtr(9), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	902: { // netherite_pickaxe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_netherite_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/pickaxe"}, Speed: floatPtr(9), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	901: { // netherite_shovel
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_netherite_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/shovel"}, Speed: floatPtr(9), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	900: { // netherite_sword
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:sword_efficient"}, Speed: floatPtr(1.5)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+	1060: { // shears
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:leaves"}, Speed: floatPtr(15)},
+			{Blocks: []string{"#minecraft:wool"}, Speed: floatPtr(5)},
+			{Blocks: []string{"minecraft:vine", "minecraft:glow_lichen"}, Speed: floatPtr(2)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	883: { // stone_axe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_stone_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/axe"}, Speed: floatPtr(4), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	884: { // stone_hoe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_stone_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/hoe"}, Speed: floatPtr(4), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	882: { // stone_pickaxe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_stone_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/pickaxe"}, Speed: floatPtr(4), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	881: { // stone_shovel
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_stone_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/shovel"}, Speed: floatPtr(4), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	880: { // stone_sword
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:sword_efficient"}, Speed: floatPtr(1.5)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+	878: { // wooden_axe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_wooden_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/axe"}, Speed: floatPtr(2), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	879: { // wooden_hoe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_wooden_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/hoe"}, Speed: floatPtr(2), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	877: { // wooden_pickaxe
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_wooden_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/pickaxe"}, Speed: floatPtr(2), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	876: { // wooden_shovel
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"#minecraft:incorrect_for_wooden_tool"}, CorrectForDrops: boolPtr(false)},
+			{Blocks: []string{"#minecraft:mineable/shovel"}, Speed: floatPtr(2), CorrectForDrops: boolPtr(true)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     1,
+	},
+	875: { // wooden_sword
+		Rules: []inventory.ToolRule{
+			{Blocks: []string{"minecraft:cobweb"}, Speed: floatPtr(15), CorrectForDrops: boolPtr(true)},
+			{Blocks: []string{"#minecraft:sword_efficient"}, Speed: floatPtr(1.5)},
+		},
+		DefaultMiningSpeed: 1,
+		DamagePerBlock:     2,
+	},
+}
+
+// EquippableMap stores the equipment slot of wearable items (Minecraft 1.21.10)
+var EquippableMap = map[int]string{
+	491:  "body",   // black_carpet
+	487:  "body",   // blue_carpet
+	488:  "body",   // brown_carpet
+	345:  "head",   // carved_pumpkin
+	920:  "feet",   // chainmail_boots
+	918:  "chest",  // chainmail_chestplate
+	917:  "head",   // chainmail_helmet
+	919:  "legs",   // chainmail_leggings
+	1187: "head",   // creeper_head
+	485:  "body",   // cyan_carpet
+	928:  "feet",   // diamond_boots
+	926:  "chest",  // diamond_chestplate
+	925:  "head",   // diamond_helmet
+	1207: "body",   // diamond_horse_armor
+	927:  "legs",   // diamond_leggings
+	1188: "head",   // dragon_head
+	826:  "chest",  // elytra
+	932:  "feet",   // golden_boots
+	930:  "chest",  // golden_chestplate
+	929:  "head",   // golden_helmet
+	1206: "body",   // golden_horse_armor
+	931:  "legs",   // golden_leggings
+	483:  "body",   // gray_carpet
+	489:  "body",   // green_carpet
+	924:  "feet",   // iron_boots
+	922:  "chest",  // iron_chestplate
+	921:  "head",   // iron_helmet
+	1205: "body",   // iron_horse_armor
+	923:  "legs",   // iron_leggings
+	916:  "feet",   // leather_boots
+	914:  "chest",  // leather_chestplate
+	913:  "head",   // leather_helmet
+	1208: "body",   // leather_horse_armor
+	915:  "legs",   // leather_leggings
+	479:  "body",   // light_blue_carpet
+	484:  "body",   // light_gray_carpet
+	481:  "body",   // lime_carpet
+	478:  "body",   // magenta_carpet
+	261:  "body",   // moss_carpet
+	936:  "feet",   // netherite_boots
+	934:  "chest",  // netherite_chestplate
+	933:  "head",   // netherite_helmet
+	935:  "legs",   // netherite_leggings
+	477:  "body",   // orange_carpet
+	263:  "body",   // pale_moss_carpet
+	1189: "head",   // piglin_head
+	482:  "body",   // pink_carpet
+	1185: "head",   // player_head
+	486:  "body",   // purple_carpet
+	490:  "body",   // red_carpet
+	801:  "saddle", // saddle
+	1183: "head",   // skeleton_skull
+	851:  "head",   // turtle_helmet
+	476:  "body",   // white_carpet
+	1184: "head",   // wither_skeleton_skull
+	854:  "body",   // wolf_armor
+	480:  "body",   // yellow_carpet
+	1186: "head",   // zombie_head
+}
+
+// RarityMap stores the rarity of items that are not common (Minecraft 1.21.10)
+var RarityMap = map[int]inventory.Rarity{
+	473:  "epic",     // barrier
+	426:  "rare",     // beacon
+	1371: "uncommon", // bolt_armor_trim_smithing_template
+	545:  "epic",     // chain_command_block
+	1356: "uncommon", // coast_armor_trim_smithing_template
+	425:  "epic",     // command_block
+	1211: "epic",     // command_block_minecart
+	651:  "rare",     // conduit
+	1278: "uncommon", // creeper_banner_pattern
+	1187: "uncommon", // creeper_head
+	1248: "epic",     // debug_stick
+	1238: "uncommon", // dragon_breath
+	408:  "epic",     // dragon_egg
+	1188: "epic",     // dragon_head
+	1355: "uncommon", // dune_armor_trim_smithing_template
+	826:  "epic",     // elytra
+	1194: "uncommon", // enchanted_book
+	942:  "epic",     // enchanted_golden_apple
+	1230: "rare",     // end_crystal
+	1167: "uncommon", // experience_bottle
+	1359: "rare",     // eye_armor_trim_smithing_template
+	1370: "uncommon", // flow_armor_trim_smithing_template
+	1283: "rare",     // flow_banner_pattern
+	941:  "rare",     // golden_apple
+	1284: "rare",     // guster_banner_pattern
+	1273: "uncommon", // heart_of_the_sea
+	87:   "epic",     // heavy_core
+	1369: "rare",     // host_armor_trim_smithing_template
+	848:  "epic",     // jigsaw
+	1247: "epic",     // knowledge_book
+	474:  "epic",     // light
+	1173: "epic",     // mace
+	1280: "rare",     // mojang_banner_pattern
+	1262: "uncommon", // music_disc_11
+	1249: "uncommon", // music_disc_13
+	1266: "uncommon", // music_disc_5
+	1251: "uncommon", // music_disc_blocks
+	1250: "uncommon", // music_disc_cat
+	1252: "uncommon", // music_disc_chirp
+	1253: "uncommon", // music_disc_creator
+	1254: "uncommon", // music_disc_creator_music_box
+	1255: "uncommon", // music_disc_far
+	1256: "uncommon", // music_disc_lava_chicken
+	1257: "uncommon", // music_disc_mall
+	1258: "uncommon", // music_disc_mellohi
+	1264: "uncommon", // music_disc_otherside
+	1267: "uncommon", // music_disc_pigstep
+	1268: "uncommon", // music_disc_precipice
+	1265: "uncommon", // music_disc_relic
+	1259: "uncommon", // music_disc_stal
+	1260: "uncommon", // music_disc_strad
+	1269: "uncommon", // music_disc_tears
+	1263: "uncommon", // music_disc_wait
+	1261: "uncommon", // music_disc_ward
+	1190: "uncommon", // nether_star
+	1353: "uncommon", // netherite_upgrade_smithing_template
+	1415: "uncommon", // ominous_bottle
+	1413: "uncommon", // ominous_trial_key
+	1282: "uncommon", // piglin_banner_pattern
+	1189: "uncommon", // piglin_head
+	1185: "uncommon", // player_head
+	1368: "rare",     // raiser_armor_trim_smithing_template
+	544:  "epic",     // repeating_command_block
+	1363: "rare",     // rib_armor_trim_smithing_template
+	1354: "uncommon", // sentry_armor_trim_smithing_template
+	1366: "rare",     // shaper_armor_trim_smithing_template
+	1367: "epic",     // silence_armor_trim_smithing_template
+	1183: "uncommon", // skeleton_skull
+	1279: "uncommon", // skull_banner_pattern
+	618:  "uncommon", // sniffer_egg
+	1362: "rare",     // snout_armor_trim_smithing_template
+	1364: "rare",     // spire_armor_trim_smithing_template
+	847:  "epic",     // structure_block
+	551:  "epic",     // structure_void
+	849:  "epic",     // test_block
+	850:  "epic",     // test_instance_block
+	1361: "rare",     // tide_armor_trim_smithing_template
+	1244: "uncommon", // totem_of_undying
+	1412: "uncommon", // trial_key
+	1271: "rare",     // trident
+	1360: "rare",     // vex_armor_trim_smithing_template
+	1358: "rare",     // ward_armor_trim_smithing_template
+	1365: "rare",     // wayfinder_armor_trim_smithing_template
+	1357: "uncommon", // wild_armor_trim_smithing_template
+	1184: "uncommon", // wither_skeleton_skull
+	1186: "uncommon", // zombie_head
+}
+
+// FireResistantMap stores items that survive fire and lava (Minecraft 1.21.10)
+var FireResistantMap = map[int]bool{
+	82:  true, // ancient_debris
+	903: true, // netherite_axe
+	94:  true, // netherite_block
+	936: true, // netherite_boots
+	934: true, // netherite_chestplate
+	933: true, // netherite_helmet
+	904: true, // netherite_hoe
+	873: true, // netherite_ingot
+	935: true, // netherite_leggings
+	902: true, // netherite_pickaxe
+	874: true, // netherite_scrap
+	901: true, // netherite_shovel
+	900: true, // netherite_sword
+}
+
+// EnchantableMap stores the enchanting table value of enchantable items (Minecraft 1.21.10)
+var EnchantableMap = map[int]int{
+	984:  1,  // book
+	858:  1,  // bow
+	920:  12, // chainmail_boots
+	918:  12, // chainmail_chestplate
+	917:  12, // chainmail_helmet
+	919:  12, // chainmail_leggings
+	1274: 1,  // crossbow
+	898:  10, // diamond_axe
+	928:  10, // diamond_boots
+	926:  10, // diamond_chestplate
+	925:  10, // diamond_helmet
+	899:  10, // diamond_hoe
+	927:  10, // diamond_leggings
+	897:  10, // diamond_pickaxe
+	896:  10, // diamond_shovel
+	895:  10, // diamond_sword
+	1008: 1,  // fishing_rod
+	888:  22, // golden_axe
+	932:  25, // golden_boots
+	930:  25, // golden_chestplate
+	929:  25, // golden_helmet
+	889:  22, // golden_hoe
+	931:  25, // golden_leggings
+	887:  22, // golden_pickaxe
+	886:  22, // golden_shovel
+	885:  22, // golden_sword
+	893:  14, // iron_axe
+	924:  9,  // iron_boots
+	922:  9,  // iron_chestplate
+	921:  9,  // iron_helmet
+	894:  14, // iron_hoe
+	923:  9,  // iron_leggings
+	892:  14, // iron_pickaxe
+	891:  14, // iron_shovel
+	890:  14, // iron_sword
+	916:  15, // leather_boots
+	914:  15, // leather_chestplate
+	913:  15, // leather_helmet
+	915:  15, // leather_leggings
+	1173: 15, // mace
+	903:  15, // netherite_axe
+	936:  15, // netherite_boots
+	934:  15, // netherite_chestplate
+	933:  15, // netherite_helmet
+	904:  15, // netherite_hoe
+	935:  15, // netherite_leggings
+	902:  15, // netherite_pickaxe
+	901:  15, // netherite_shovel
+	900:  15, // netherite_sword
+	883:  5,  // stone_axe
+	884:  5,  // stone_hoe
+	882:  5,  // stone_pickaxe
+	881:  5,  // stone_shovel
+	880:  5,  // stone_sword
+	1271: 1,  // trident
+	851:  9,  // turtle_helmet
+	878:  15, // wooden_axe
+	879:  15, // wooden_hoe
+	877:  15, // wooden_pickaxe
+	876:  15, // wooden_shovel
+	875:  15, // wooden_sword
+}
+
+// RepairableMap stores the item or tag that repairs each item in an anvil (Minecraft 1.21.10)
+var RepairableMap = map[int]string{
+	920:  "#minecraft:repairs_chain_armor",      // chainmail_boots
+	918:  "#minecraft:repairs_chain_armor",      // chainmail_chestplate
+	917:  "#minecraft:repairs_chain_armor",      // chainmail_helmet
+	919:  "#minecraft:repairs_chain_armor",      // chainmail_leggings
+	898:  "#minecraft:diamond_tool_materials",   // diamond_axe
+	928:  "#minecraft:repairs_diamond_armor",    // diamond_boots
+	926:  "#minecraft:repairs_diamond_armor",    // diamond_chestplate
+	925:  "#minecraft:repairs_diamond_armor",    // diamond_helmet
+	899:  "#minecraft:diamond_tool_materials",   // diamond_hoe
+	927:  "#minecraft:repairs_diamond_armor",    // diamond_leggings
+	897:  "#minecraft:diamond_tool_materials",   // diamond_pickaxe
+	896:  "#minecraft:diamond_tool_materials",   // diamond_shovel
+	895:  "#minecraft:diamond_tool_materials",   // diamond_sword
+	826:  "minecraft:phantom_membrane",          // elytra
+	888:  "#minecraft:gold_tool_materials",      // golden_axe
+	932:  "#minecraft:repairs_gold_armor",       // golden_boots
+	930:  "#minecraft:repairs_gold_armor",       // golden_chestplate
+	929:  "#minecraft:repairs_gold_armor",       // golden_helmet
+	889:  "#minecraft:gold_tool_materials",      // golden_hoe
+	931:  "#minecraft:repairs_gold_armor",       // golden_leggings
+	887:  "#minecraft:gold_tool_materials",      // golden_pickaxe
+	886:  "#minecraft:gold_tool_materials",      // golden_shovel
+	885:  "#minecraft:gold_tool_materials",      // golden_sword
+	893:  "#minecraft:iron_tool_materials",      // iron_axe
+	924:  "#minecraft:repairs_iron_armor",       // iron_boots
+	922:  "#minecraft:repairs_iron_armor",       // iron_chestplate
+	921:  "#minecraft:repairs_iron_armor",       // iron_helmet
+	894:  "#minecraft:iron_tool_materials",      // iron_hoe
+	923:  "#minecraft:repairs_iron_armor",       // iron_leggings
+	892:  "#minecraft:iron_tool_materials",      // iron_pickaxe
+	891:  "#minecraft:iron_tool_materials",      // iron_shovel
+	890:  "#minecraft:iron_tool_materials",      // iron_sword
+	916:  "#minecraft:repairs_leather_armor",    // leather_boots
+	914:  "#minecraft:repairs_leather_armor",    // leather_chestplate
+	913:  "#minecraft:repairs_leather_armor",    // leather_helmet
+	915:  "#minecraft:repairs_leather_armor",    // leather_leggings
+	1173: "minecraft:breeze_rod",                // mace
+	903:  "#minecraft:netherite_tool_materials", // netherite_axe
+	936:  "#minecraft:repairs_netherite_armor",  // netherite_boots
+	934:  "#minecraft:repairs_netherite_armor",  // netherite_chestplate
+	933:  "#minecraft:repairs_netherite_armor",  // netherite_helmet
+	904:  "#minecraft:netherite_tool_materials", // netherite_hoe
+	935:  "#minecraft:repairs_netherite_armor",  // netherite_leggings
+	902:  "#minecraft:netherite_tool_materials", // netherite_pickaxe
+	901:  "#minecraft:netherite_tool_materials", // netherite_shovel
+	900:  "#minecraft:netherite_tool_materials", // netherite_sword
+	1243: "#minecraft:wooden_tool_materials",    // shield
+	883:  "#minecraft:stone_tool_materials",     // stone_axe
+	884:  "#minecraft:stone_tool_materials",     // stone_hoe
+	882:  "#minecraft:stone_tool_materials",     // stone_pickaxe
+	881:  "#minecraft:stone_tool_materials",     // stone_shovel
+	880:  "#minecraft:stone_tool_materials",     // stone_sword
+	851:  "#minecraft:repairs_turtle_helmet",    // turtle_helmet
+	854:  "#minecraft:repairs_wolf_armor",       // wolf_armor
+	878:  "#minecraft:wooden_tool_materials",    // wooden_axe
+	879:  "#minecraft:wooden_tool_materials",    // wooden_hoe
+	877:  "#minecraft:wooden_tool_materials",    // wooden_pickaxe
+	876:  "#minecraft:wooden_tool_materials",    // wooden_shovel
+	875:  "#minecraft:wooden_tool_materials",    // wooden_sword
+}
+
+// AttributeModifierMap stores the attribute modifiers of armor and weapons (Minecraft 1.21.10)
+var AttributeModifierMap = map[int][]inventory.AttributeModifier{
+	920: { // chainmail_boots
+		{Type: "minecraft:armor", ID: "minecraft:armor.boots", Amount: 1, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.boots", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "feet"},
+	},
+	918: { // chainmail_chestplate
+		{Type: "minecraft:armor", ID: "minecraft:armor.chestplate", Amount: 5, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.chestplate", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "chest"},
+	},
+	917: { // chainmail_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	919: { // chainmail_leggings
+		{Type: "minecraft:armor", ID: "minecraft:armor.leggings", Amount: 4, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.leggings", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "legs"},
+	},
+	898: { // diamond_axe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	928: { // diamond_boots
+		{Type: "minecraft:armor", ID: "minecraft:armor.boots", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.boots", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "feet"},
+	},
+	926: { // diamond_chestplate
+		{Type: "minecraft:armor", ID: "minecraft:armor.chestplate", Amount: 8, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.chestplate", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "chest"},
+	},
+	925: { // diamond_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	899: { // diamond_hoe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	927: { // diamond_leggings
+		{Type: "minecraft:armor", ID: "minecraft:armor.leggings", Amount: 6, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.leggings", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "legs"},
+	},
+	897: { // diamond_pickaxe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	896: { // diamond_shovel
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 4.5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	895: { // diamond_sword
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 6, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	888: { // golden_axe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 6, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	932: { // golden_boots
+		{Type: "minecraft:armor", ID: "minecraft:armor.boots", Amount: 1, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.boots", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "feet"},
+	},
+	930: { // golden_chestplate
+		{Type: "minecraft:armor", ID: "minecraft:armor.chestplate", Amount: 5, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.chestplate", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "chest"},
+	},
+	929: { // golden_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	889: { // golden_hoe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	931: { // golden_leggings
+		{Type: "minecraft:armor", ID: "minecraft:armor.leggings", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.leggings", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "legs"},
+	},
+	887: { // golden_pickaxe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 1, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	886: { // golden_shovel
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 1.5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	885: { // golden_sword
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	893: { // iron_axe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3.1, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	924: { // iron_boots
+		{Type: "minecraft:armor", ID: "minecraft:armor.boots", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.boots", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "feet"},
+	},
+	922: { // iron_chestplate
+		{Type: "minecraft:armor", ID: "minecraft:armor.chestplate", Amount: 6, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.chestplate", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "chest"},
+	},
+	921: { // iron_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	894: { // iron_hoe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -1, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	923: { // iron_leggings
+		{Type: "minecraft:armor", ID: "minecraft:armor.leggings", Amount: 5, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.leggings", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "legs"},
+	},
+	892: { // iron_pickaxe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	891: { // iron_shovel
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 3.5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	890: { // iron_sword
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	916: { // leather_boots
+		{Type: "minecraft:armor", ID: "minecraft:armor.boots", Amount: 1, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.boots", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "feet"},
+	},
+	914: { // leather_chestplate
+		{Type: "minecraft:armor", ID: "minecraft:armor.chestplate", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.chestplate", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "chest"},
+	},
+	913: { // leather_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 1, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	915: { // leather_leggings
+		{Type: "minecraft:armor", ID: "minecraft:armor.leggings", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.leggings", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "legs"},
+	},
+	1173: { // mace
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	903: { // netherite_axe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 9, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	936: { // netherite_boots
+		{Type: "minecraft:armor", ID: "minecraft:armor.boots", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.boots", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "feet"},
+		{Type: "minecraft:knockback_resistance", ID: "minecraft:armor.boots", Amount: 0.1, Operation: inventory.AttributeAddValue, Slot: "feet"},
+	},
+	934: { // netherite_chestplate
+		{Type: "minecraft:armor", ID: "minecraft:armor.chestplate", Amount: 8, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.chestplate", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "chest"},
+		{Type: "minecraft:knockback_resistance", ID: "minecraft:armor.chestplate", Amount: 0.1, Operation: inventory.AttributeAddValue, Slot: "chest"},
+	},
+	933: { // netherite_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:knockback_resistance", ID: "minecraft:armor.helmet", Amount: 0.1, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	904: { // netherite_hoe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	935: { // netherite_leggings
+		{Type: "minecraft:armor", ID: "minecraft:armor.leggings", Amount: 6, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.leggings", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "legs"},
+		{Type: "minecraft:knockback_resistance", ID: "minecraft:armor.leggings", Amount: 0.1, Operation: inventory.AttributeAddValue, Slot: "legs"},
+	},
+	902: { // netherite_pickaxe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	901: { // netherite_shovel
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 5.5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	900: { // netherite_sword
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 7, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	883: { // stone_axe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3.2, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	884: { // stone_hoe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	882: { // stone_pickaxe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	881: { // stone_shovel
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 2.5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	880: { // stone_sword
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	1271: { // trident
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.9, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	851: { // turtle_helmet
+		{Type: "minecraft:armor", ID: "minecraft:armor.helmet", Amount: 2, Operation: inventory.AttributeAddValue, Slot: "head"},
+		{Type: "minecraft:armor_toughness", ID: "minecraft:armor.helmet", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "head"},
+	},
+	878: { // wooden_axe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 6, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3.2, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	879: { // wooden_hoe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 0, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	877: { // wooden_pickaxe
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 1, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.8, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	876: { // wooden_shovel
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 1.5, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+	875: { // wooden_sword
+		{Type: "minecraft:attack_damage", ID: "minecraft:base_attack_damage", Amount: 3, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+		{Type: "minecraft:attack_speed", ID: "minecraft:base_attack_speed", Amount: -2.4, Operation: inventory.AttributeAddValue, Slot: "mainhand"},
+	},
+}
+
 // GetMaxStackSize returns the maximum stack size for an item
 func GetMaxStackSize(id int) int {
 	if size, ok := StackSizeMap[id]; ok {
@@ -2928,3 +3766,55 @@ func GetMaxDurability(id int) int {
 	}
 	return 0 // No durability
 }
+
+// GetFood returns the food properties of an item
+func GetFood(id int) (inventory.Food, bool) {
+	food, ok := FoodMap[id]
+	return food, ok
+}
+
+// GetTool returns the mining rules of a tool (nil if the item is not a tool)
+func GetTool(id int) *inventory.Tool {
+	return ToolMap[id]
+}
+
+// GetEquippableSlot returns the equipment slot of an item (empty if it cannot be worn)
+func GetEquippableSlot(id int) string {
+	return EquippableMap[id]
+}
+
+// GetRarity returns the rarity of an item
+func GetRarity(id int) inventory.Rarity {
+	if rarity, ok := RarityMap[id]; ok {
+		return rarity
+	}
+	return inventory.RarityCommon
+}
+
+// IsFireResistant checks if an item survives fire and lava
+func IsFireResistant(id int) bool {
+	return FireResistantMap[id]
+}
+
+// GetEnchantable returns the enchanting table value of an item (0 if not enchantable)
+func GetEnchantable(id int) int {
+	return EnchantableMap[id]
+}
+
+// GetRepairable returns the item or tag that repairs an item (empty if not repairable)
+func GetRepairable(id int) string {
+	return RepairableMap[id]
+}
+
+// GetAttributeModifiers returns the attribute modifiers of an item (nil if it has none)
+func GetAttributeModifiers(id int) []inventory.AttributeModifier {
+	return AttributeModifierMap[id]
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"embed"
+	"errors"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/chat"
+)
+
+// DefaultLocale is the language every other language falls back to
+const DefaultLocale = "en_us"
+
+var ErrUnknownLanguage = errors.New("unknown language")
+
+// langFiles holds the language files. minecraft_data/<version>/lang holds the
+// vanilla client files for each version (en_us and other locales), written by
+// tools/langfetch.go. lang/en_us.json is a hand-maintained subset (chat, join,
+// death and advancement messages, containers, keybinds, and names built from
+// the 1.21.4 registries) used only while no vanilla files have been fetched.
+//
+//go:embed lang/*.json all:minecraft_data/*/lang
+var langFiles embed.FS
+
+// translatorCache stores loaded translators by language directory and locale
+var (
+	translatorCache = make(map[string]*chat.Translator)
+	translatorMu    sync.Mutex
+)
+
+// Languages lists the locales available for a Minecraft version
+func Languages(version string) []string {
+	dir := langDir(version)
+	if dir == "" {
+		return []string{DefaultLocale}
+	}
+	entries, err := langFiles.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	locales := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if locale, ok := strings.CutSuffix(e.Name(), ".json"); ok {
+			locales = append(locales, locale)
+		}
+	}
+	return locales
+}
+
+// LoadTranslator returns the translator for a version and locale. Keys missing
+// from the locale fall back to en_us.
+func LoadTranslator(version, locale string) (*chat.Translator, error) {
+	translatorMu.Lock()
+	defer translatorMu.Unlock()
+	return loadTranslator(langDir(version), strings.ToLower(locale))
+}
+
+// Translator returns the translator for a locale of the registry version
+func (r *Registry) Translator(locale string) (*chat.Translator, error) {
+	return LoadTranslator(r.Version, locale)
+}
+
+// loadTranslator parses and caches a language file (caller holds translatorMu).
+// An empty dir selects the bundled en_us subset.
+func loadTranslator(dir, locale string) (*chat.Translator, error) {
+	key := dir + "/" + locale
+	if t, ok := translatorCache[key]; ok {
+		return t, nil
+	}
+	name := path.Join(dir, locale+".json")
+	if dir == "" {
+		name = path.Join("lang", locale+".json")
+	}
+	raw, err := langFiles.ReadFile(name)
+	if err != nil {
+		return nil, ErrUnknownLanguage
+	}
+	var fallback *chat.Translator
+	if locale != DefaultLocale {
+		if fallback, err = loadTranslator(dir, DefaultLocale); err != nil {
+			return nil, err
+		}
+	}
+	t, err := chat.ParseLanguage(locale, raw, fallback)
+	if err != nil {
+		return nil, err
+	}
+	translatorCache[key] = t
+	return t, nil
+}
+
+// langDir picks the vanilla language directory of the newest data version not
+// newer than the version (the oldest one for older versions), or "" if no
+// vanilla files have been fetched
+func langDir(version string) string {
+	entries, _ := langFiles.ReadDir("minecraft_data")
+	versions := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if _, err := fs.Stat(langFiles, path.Join("minecraft_data", e.Name(), "lang", DefaultLocale+".json")); err == nil {
+			versions = append(versions, e.Name())
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool { return compareVersions(versions[i], versions[j]) < 0 })
+
+	best := ""
+	for _, v := range versions {
+		if best == "" || compareVersions(v, version) <= 0 {
+			best = v
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return path.Join("minecraft_data", best, "lang")
+}
+
+// compareVersions compares dotted version numbers
+func compareVersions(a, b string) int {
+	pa, pb := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(pa), len(pb)); i++ {
+		var na, nb int
+		if i < len(pa) {
+			na, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			nb, _ = strconv.Atoi(pb[i])
+		}
+		if na != nb {
+			return na - nb
+		}
+	}
+	return 0
+}
@@ -8,12 +8,17 @@ import (
 	"github.com/konjacbot/prismarine-go/world"
 )
 
-// Registry holds all game data (blocks, items, entities)
+// Registry holds all game data (blocks, items, entities, attributes, effects, recipes, enchantments, tags)
 type Registry struct {
-	Blocks   map[int]*world.BlockInfo
-	Items    map[int]*inventory.ItemInfo
-	Entities map[int32]*entity.EntityInfo
-	Version  string
+	Blocks       map[int]*world.BlockInfo
+	Items        map[int]*inventory.ItemInfo
+	Entities     map[int32]*entity.EntityInfo
+	Attributes   []entity.AttributeInfo
+	Effects      []entity.EffectInfo
+	Recipes      []inventory.Recipe
+	Enchantments []inventory.EnchantmentInfo
+	Tags         *TagSet
+	Version      string
 }
 
 // NewRegistry creates a new empty registry
@@ -22,6 +27,7 @@ func NewRegistry(version string) *Registry {
 		Blocks:   make(map[int]*world.BlockInfo),
 		Items:    make(map[int]*inventory.ItemInfo),
 		Entities: make(map[int32]*entity.EntityInfo),
+		Tags:     NewTagSet(nil),
 		Version:  version,
 	}
 }
@@ -38,12 +44,180 @@ func (r *Registry) GetItem(id int) (*inventory.ItemInfo, bool) {
 	return info, ok
 }
 
+// NewItem returns the item type with its default components
+func (r *Registry) NewItem(id int) (inventory.Item, bool) {
+	info, ok := r.Items[id]
+	if !ok {
+		return inventory.Item{}, false
+	}
+	return inventory.Item{
+		ID:        info.ID,
+		Name:      info.Name,
+		StackSize: info.StackSize,
+		Prototype: info.Prototype,
+	}, true
+}
+
+// NewItemStack creates a stack of an item with no component changes
+func (r *Registry) NewItemStack(id, count int) (inventory.ItemStack, bool) {
+	item, ok := r.NewItem(id)
+	if !ok {
+		return inventory.ItemStack{}, false
+	}
+	return inventory.ItemStack{Item: item, Count: count}, true
+}
+
 // GetEntity gets entity info by type ID
 func (r *Registry) GetEntity(typeID int32) (*entity.EntityInfo, bool) {
 	info, ok := r.Entities[typeID]
 	return info, ok
 }
 
+// GetAttribute gets attribute info by registry ID
+func (r *Registry) GetAttribute(id int) (entity.AttributeInfo, bool) {
+	if id < 0 || id >= len(r.Attributes) {
+		return entity.AttributeInfo{}, false
+	}
+	return r.Attributes[id], true
+}
+
+// AttributeByName gets attribute info by name (legacy "generic." names are accepted)
+func (r *Registry) AttributeByName(name string) (entity.AttributeInfo, bool) {
+	name = entity.AttributeName(name)
+	for _, info := range r.Attributes {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	return entity.AttributeInfo{}, false
+}
+
+// NewAttributes creates an attribute container for an entity type with the
+// registry's default base values (and the player base values for players)
+func (r *Registry) NewAttributes(typeID int32) *entity.Attributes {
+	if info, ok := r.Entities[typeID]; ok && info.Name == "player" {
+		return entity.NewPlayerAttributes(r.Attributes)
+	}
+	return entity.NewAttributes(r.Attributes)
+}
+
+// GetEffect gets status effect info by registry ID
+func (r *Registry) GetEffect(id int) (entity.EffectInfo, bool) {
+	if id < 0 || id >= len(r.Effects) {
+		return entity.EffectInfo{}, false
+	}
+	return r.Effects[id], true
+}
+
+// EffectByName gets status effect info by name (e.g. "minecraft:speed")
+func (r *Registry) EffectByName(name string) (entity.EffectInfo, bool) {
+	name = TagName(name)
+	for _, info := range r.Effects {
+		if info.Name == name {
+			return info, true
+		}
+	}
+	return entity.EffectInfo{}, false
+}
+
+// RecipeBook indexes the registry's recipes for matching and planning,
+// resolving tag ingredients with the registry's item tags
+func (r *Registry) RecipeBook() *inventory.RecipeBook {
+	return inventory.NewRecipeBook(r.Recipes, r)
+}
+
+// EnchantmentRegistry indexes the registry's enchantments, resolving supported
+// items with the registry's item tags
+func (r *Registry) EnchantmentRegistry() *inventory.EnchantmentRegistry {
+	return inventory.NewEnchantmentRegistry(r.Enchantments, r)
+}
+
+// BlockHasTag checks if a block is in a block tag (e.g. "minecraft:logs")
+func (r *Registry) BlockHasTag(id int, tag string) bool {
+	info, ok := r.Blocks[id]
+	return ok && r.Tags.Has(TagBlock, tag, info.Name)
+}
+
+// ItemHasTag checks if an item is in an item tag
+func (r *Registry) ItemHasTag(id int, tag string) bool {
+	info, ok := r.Items[id]
+	return ok && r.Tags.Has(TagItem, tag, info.Name)
+}
+
+// EntityHasTag checks if an entity type is in an entity type tag (e.g. "minecraft:undead")
+func (r *Registry) EntityHasTag(typeID int32, tag string) bool {
+	info, ok := r.Entities[typeID]
+	return ok && r.Tags.Has(TagEntityType, tag, info.Name)
+}
+
+// FluidHasTag checks if a fluid (e.g. "minecraft:flowing_water") is in a fluid tag
+func (r *Registry) FluidHasTag(fluid, tag string) bool {
+	return r.Tags.Has(TagFluid, tag, fluid)
+}
+
+// Tag returns the entries of a tag, looking it up in the block, item,
+// entity type and fluid registries in that order
+func (r *Registry) Tag(name string) []string {
+	for _, kind := range TagTypes {
+		if r.Tags.Exists(kind, name) {
+			return r.Tags.Tag(kind, name)
+		}
+	}
+	return nil
+}
+
+// FluidNames maps fluid registry IDs to names
+var FluidNames = []string{"minecraft:empty", "minecraft:flowing_water", "minecraft:water", "minecraft:flowing_lava", "minecraft:lava"}
+
+// ApplyServerTags replaces a registry's tags with the ones sent by the server,
+// whose entries are registry IDs. Unknown IDs are skipped.
+func (r *Registry) ApplyServerTags(kind TagType, tags map[string][]int) {
+	named := make(map[string][]string, len(tags))
+	for tag, ids := range tags {
+		entries := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if name, ok := r.registryName(kind, id); ok {
+				entries = append(entries, name)
+			}
+		}
+		named[tag] = entries
+	}
+	r.Tags.Replace(kind, named)
+}
+
+// registryName returns the name of a registry entry by ID
+func (r *Registry) registryName(kind TagType, id int) (string, bool) {
+	switch kind {
+	case TagBlock:
+		if info, ok := r.Blocks[id]; ok {
+			return info.Name, true
+		}
+	case TagItem:
+		if info, ok := r.Items[id]; ok {
+			return info.Name, true
+		}
+	case TagEntityType:
+		if info, ok := r.Entities[int32(id)]; ok {
+			return info.Name, true
+		}
+	case TagFluid:
+		if id >= 0 && id < len(FluidNames) {
+			return FluidNames[id], true
+		}
+	}
+	return "", false
+}
+
+// HasTag checks if an item (by name) is in an item tag
+func (r *Registry) HasTag(item, tag string) bool {
+	return r.Tags.Has(TagItem, tag, item)
+}
+
+// TagItems lists the items in an item tag
+func (r *Registry) TagItems(tag string) []string {
+	return r.Tags.Tag(TagItem, tag)
+}
+
 // ProtocolToVersion maps protocol version numbers to Minecraft version strings
 var ProtocolToVersion = map[int32]string{
 	767: "1.21.0",
@@ -132,6 +306,40 @@ func IsProtocolSupported(protocolVersion int32) bool {
 	return ok
 }
 
+// ItemPrototype builds the default components of an item from the generated data
+func ItemPrototype(id int) inventory.Components {
+	components := inventory.Components{
+		inventory.ComponentMaxStackSize: GetMaxStackSize(id),
+		inventory.ComponentRarity:       GetRarity(id),
+	}
+	if durability := GetMaxDurability(id); durability > 0 {
+		components[inventory.ComponentMaxDamage] = durability
+		components[inventory.ComponentDamage] = 0
+	}
+	if food, ok := GetFood(id); ok {
+		components[inventory.ComponentFood] = &food
+	}
+	if tool := GetTool(id); tool != nil {
+		components[inventory.ComponentTool] = tool
+	}
+	if slot := GetEquippableSlot(id); slot != "" {
+		components[inventory.ComponentEquippable] = &inventory.Equippable{Slot: slot}
+	}
+	if IsFireResistant(id) {
+		components[inventory.ComponentDamageResistant] = inventory.DamageTypeFire
+	}
+	if value := GetEnchantable(id); value > 0 {
+		components[inventory.ComponentEnchantable] = value
+	}
+	if repair := GetRepairable(id); repair != "" {
+		components[inventory.ComponentRepairable] = repair
+	}
+	if modifiers := GetAttributeModifiers(id); modifiers != nil {
+		components[inventory.ComponentAttributeModifiers] = modifiers
+	}
+	return components
+}
+
 // loadRegistryData populates a registry with game data
 // Currently all versions 1.21.0-1.21.10 share the same data
 // (future versions with different data can be handled here)
@@ -139,10 +347,13 @@ func loadRegistryData(registry *Registry) {
 	// Load all blocks from BlockNameToID
 	for name, id := range BlockNameToID {
 		registry.Blocks[id] = &world.BlockInfo{
-			ID:       id,
-			Name:     "minecraft:" + name,
-			Solid:    IsSolid(id),
-			Hardness: GetHardness(id),
+			ID:           id,
+			Name:         "minecraft:" + name,
+			Solid:        IsSolid(id),
+			Hardness:     GetHardness(id),
+			RequiresTool: RequiresTool(id),
+			Resistance:   GetBlastResistance(id),
+			MapColor:     GetMapColor(id),
 		}
 	}
 
@@ -153,9 +364,19 @@ func loadRegistryData(registry *Registry) {
 			Name:       "minecraft:" + name,
 			StackSize:  GetMaxStackSize(id),
 			Durability: GetMaxDurability(id),
+			Prototype:  ItemPrototype(id),
 		}
 	}
 
+	// Attributes, effects, recipes and enchantments are shared; the indexes reference them without copying
+	registry.Attributes = Attributes
+	registry.Effects = Effects
+	registry.Recipes = Recipes
+	registry.Enchantments = Enchantments
+
+	// Tags get a per-registry copy so runtime overrides stay local
+	registry.Tags = NewTagSet(VanillaTags)
+
 	// Load all entities from EntityNameToID
 	// Note: We don't have width/height data in the extracted gamedata yet,
 	// so we'll use default values. This can be enhanced later.
@@ -0,0 +1,180 @@
+package data
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// TagType is the registry a tag groups entries of
+type TagType string
+
+const (
+	TagBlock      TagType = "block"
+	TagItem       TagType = "item"
+	TagEntityType TagType = "entity_type"
+	TagFluid      TagType = "fluid"
+)
+
+// TagTypes lists the tag registries in lookup order
+var TagTypes = []TagType{TagBlock, TagItem, TagEntityType, TagFluid}
+
+// TagSet holds tag definitions and resolves nested tag references.
+// Definitions can be overridden at runtime (e.g. from the server's Update Tags packet).
+type TagSet struct {
+	mu       sync.RWMutex
+	defs     map[TagType]map[string][]string
+	resolved map[TagType]map[string]map[string]bool
+}
+
+// NewTagSet creates a tag set from definitions (entries may reference other tags with "#")
+func NewTagSet(defs map[TagType]map[string][]string) *TagSet {
+	t := &TagSet{
+		defs:     make(map[TagType]map[string][]string),
+		resolved: make(map[TagType]map[string]map[string]bool),
+	}
+	for kind, tags := range defs {
+		t.define(kind, tags)
+	}
+	return t
+}
+
+// TagName normalizes a tag name: strips "#" and adds the "minecraft:" namespace
+func TagName(name string) string {
+	name = strings.TrimPrefix(name, "#")
+	if !strings.Contains(name, ":") {
+		name = "minecraft:" + name
+	}
+	return name
+}
+
+func normalizeEntries(entries []string) []string {
+	out := make([]string, len(entries))
+	for i, entry := range entries {
+		if strings.HasPrefix(entry, "#") {
+			out[i] = "#" + TagName(entry)
+		} else {
+			out[i] = TagName(entry)
+		}
+	}
+	return out
+}
+
+// Has checks if an entry (e.g. "minecraft:oak_log") is in a tag
+func (t *TagSet) Has(kind TagType, tag, entry string) bool {
+	return t.members(kind, TagName(tag))[TagName(entry)]
+}
+
+// Tag returns the sorted entries of a tag with nested tags resolved
+// (nil if the tag does not exist)
+func (t *TagSet) Tag(kind TagType, tag string) []string {
+	members := t.members(kind, TagName(tag))
+	if members == nil {
+		return nil
+	}
+	entries := make([]string, 0, len(members))
+	for entry := range members {
+		entries = append(entries, entry)
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+// Exists checks if a tag is defined
+func (t *TagSet) Exists(kind TagType, tag string) bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	_, ok := t.defs[kind][TagName(tag)]
+	return ok
+}
+
+// Names returns the sorted names of the tags of a registry
+func (t *TagSet) Names(kind TagType) []string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	names := make([]string, 0, len(t.defs[kind]))
+	for name := range t.defs[kind] {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Set defines or overrides a tag
+func (t *TagSet) Set(kind TagType, tag string, entries []string) {
+	t.Update(kind, map[string][]string{tag: entries})
+}
+
+// Update defines or overrides the given tags, keeping the others
+func (t *TagSet) Update(kind TagType, tags map[string][]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.define(kind, tags)
+}
+
+// Replace replaces all tags of a registry
+func (t *TagSet) Replace(kind TagType, tags map[string][]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.defs, kind)
+	t.define(kind, tags)
+}
+
+// define stores tag definitions and drops the registry's resolved cache
+func (t *TagSet) define(kind TagType, tags map[string][]string) {
+	if t.defs[kind] == nil {
+		t.defs[kind] = make(map[string][]string)
+	}
+	for name, entries := range tags {
+		t.defs[kind][TagName(name)] = normalizeEntries(entries)
+	}
+	delete(t.resolved, kind)
+}
+
+// members returns the resolved set of a tag, resolving and caching it on first use
+func (t *TagSet) members(kind TagType, tag string) map[string]bool {
+	t.mu.RLock()
+	members, ok := t.resolved[kind][tag]
+	t.mu.RUnlock()
+	if ok {
+		return members
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if _, ok := t.defs[kind][tag]; !ok {
+		return nil
+	}
+	if t.resolved[kind] == nil {
+		t.resolved[kind] = make(map[string]map[string]bool)
+	}
+	return t.resolve(kind, tag, map[string]bool{})
+}
+
+// resolve expands nested tag references; cycles and unknown tags contribute nothing
+func (t *TagSet) resolve(kind TagType, tag string, visiting map[string]bool) map[string]bool {
+	if members, ok := t.resolved[kind][tag]; ok {
+		return members
+	}
+	visiting[tag] = true
+	members := make(map[string]bool)
+	for _, entry := range t.defs[kind][tag] {
+		nested, ok := strings.CutPrefix(entry, "#")
+		if !ok {
+			members[entry] = true
+			continue
+		}
+		if visiting[nested] {
+			continue
+		}
+		if _, ok := t.defs[kind][nested]; !ok {
+			continue
+		}
+		for member := range t.resolve(kind, nested, visiting) {
+			members[member] = true
+		}
+	}
+	delete(visiting, tag)
+	t.resolved[kind][tag] = members
+	return members
+}
@@ -9,24 +9,133 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/konjacbot/prismarine-go/data"
+	"github.com/konjacbot/prismarine-go/inventory"
 )
 
 // BlockData represents a block in JSON format
 type BlockData struct {
-	ID       int     `json:"id"`
-	Name     string  `json:"name"`
-	Solid    bool    `json:"solid"`
-	Hardness float64 `json:"hardness"`
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Solid        bool    `json:"solid"`
+	Hardness     float64 `json:"hardness"`
+	RequiresTool bool    `json:"requiresTool"`
+	Resistance   float64 `json:"resistance"`
+	MapColor     int     `json:"mapColor"`
 }
 
 // ItemData represents an item in JSON format
 type ItemData struct {
-	ID         int    `json:"id"`
-	Name       string `json:"name"`
-	StackSize  int    `json:"stackSize"`
-	Durability int    `json:"durability"`
+	ID                 int                     `json:"id"`
+	Name               string                  `json:"name"`
+	StackSize          int                     `json:"stackSize"`
+	Durability         int                     `json:"durability"`
+	Food               *FoodData               `json:"food,omitempty"`
+	Tool               *ToolData               `json:"tool,omitempty"`
+	Equippable         string                  `json:"equippable,omitempty"`
+	Rarity             string                  `json:"rarity,omitempty"`
+	FireResistant      bool                    `json:"fireResistant,omitempty"`
+	Enchantable        int                     `json:"enchantable,omitempty"`
+	Repairable         string                  `json:"repairable,omitempty"`
+	AttributeModifiers []AttributeModifierData `json:"attributeModifiers,omitempty"`
+}
+
+// FoodData represents an item's food properties in JSON format
+type FoodData struct {
+	Nutrition    int     `json:"nutrition"`
+	Saturation   float64 `json:"saturation"`
+	CanAlwaysEat bool    `json:"canAlwaysEat"`
+}
+
+// ToolData represents an item's tool rules in JSON format
+type ToolData struct {
+	Rules              []ToolRuleData `json:"rules"`
+	DefaultMiningSpeed float64        `json:"defaultMiningSpeed"`
+	DamagePerBlock     int            `json:"damagePerBlock"`
+}
+
+// AttributeModifierData represents an item's attribute modifier in JSON format
+type AttributeModifierData struct {
+	Type      string  `json:"type"`
+	ID        string  `json:"id"`
+	Amount    float64 `json:"amount"`
+	Operation string  `json:"operation"`
+	Slot      string  `json:"slot"`
+}
+
+// ToolRuleData represents a single tool rule in JSON format
+type ToolRuleData struct {
+	Blocks          []string `json:"blocks"`
+	Speed           *float64 `json:"speed,omitempty"`
+	CorrectForDrops *bool    `json:"correctForDrops,omitempty"`
+}
+
+// RecipeData represents a recipe in JSON format
+type RecipeData struct {
+	ID          string              `json:"id"`
+	Type        string              `json:"type"`
+	Category    string              `json:"category,omitempty"`
+	Group       string              `json:"group,omitempty"`
+	Pattern     []string            `json:"pattern,omitempty"`
+	Key         map[string][]string `json:"key,omitempty"`
+	Ingredients [][]string          `json:"ingredients,omitempty"`
+	Template    []string            `json:"template,omitempty"`
+	Base        []string            `json:"base,omitempty"`
+	Addition    []string            `json:"addition,omitempty"`
+	Result      *RecipeResultData   `json:"result,omitempty"`
+	Experience  float64             `json:"experience,omitempty"`
+	CookingTime int                 `json:"cookingTime,omitempty"`
+}
+
+// RecipeResultData represents a recipe result in JSON format
+type RecipeResultData struct {
+	Item  string `json:"item"`
+	Count int    `json:"count"`
+}
+
+// TagsData holds tag definitions by registry (block, item, entity_type, fluid) in JSON format
+type TagsData map[string]map[string][]string
+
+// EnchantmentData represents an enchantment in JSON format
+type EnchantmentData struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	MaxLevel       int             `json:"maxLevel"`
+	Weight         int             `json:"weight"`
+	AnvilCost      int             `json:"anvilCost"`
+	MinCost        EnchantCostData `json:"minCost"`
+	MaxCost        EnchantCostData `json:"maxCost"`
+	Slots          []string        `json:"slots"`
+	SupportedItems string          `json:"supportedItems"`
+	PrimaryItems   string          `json:"primaryItems,omitempty"`
+	ExclusiveSet   []string        `json:"exclusiveSet,omitempty"`
+	Treasure       bool            `json:"treasure,omitempty"`
+	Curse          bool            `json:"curse,omitempty"`
+}
+
+// AttributeData represents an attribute type in JSON format
+type AttributeData struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Default float64 `json:"default"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+}
+
+// EffectData represents a status effect in JSON format
+type EffectData struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Color    int    `json:"color"`
+}
+
+// EnchantCostData represents an enchanting cost in JSON format
+type EnchantCostData struct {
+	Base     int `json:"base"`
+	PerLevel int `json:"perLevel"`
 }
 
 // EntityData represents an entity in JSON format
@@ -64,6 +173,31 @@ func main() {
 			fmt.Printf("Error extracting entities: %v\n", err)
 		}
 
+		// Extract recipes
+		if err := extractRecipes(versionDir); err != nil {
+			fmt.Printf("Error extracting recipes: %v\n", err)
+		}
+
+		// Extract enchantments
+		if err := extractEnchantments(versionDir); err != nil {
+			fmt.Printf("Error extracting enchantments: %v\n", err)
+		}
+
+		// Extract attributes
+		if err := extractAttributes(versionDir); err != nil {
+			fmt.Printf("Error extracting attributes: %v\n", err)
+		}
+
+		// Extract effects
+		if err := extractEffects(versionDir); err != nil {
+			fmt.Printf("Error extracting effects: %v\n", err)
+		}
+
+		// Extract tags
+		if err := extractTags(versionDir); err != nil {
+			fmt.Printf("Error extracting tags: %v\n", err)
+		}
+
 		fmt.Printf("✅ Extracted %s\n", version)
 	}
 
@@ -75,26 +209,62 @@ func extractBlocks(versionDir string) error {
 
 	for name, id := range data.BlockNameToID {
 		blocks[name] = BlockData{
-			ID:       id,
-			Name:     name,
-			Solid:    data.IsSolid(id),
-			Hardness: data.GetHardness(id),
+			ID:           id,
+			Name:         name,
+			Solid:        data.IsSolid(id),
+			Hardness:     data.GetHardness(id),
+			RequiresTool: data.RequiresTool(id),
+			Resistance:   data.GetBlastResistance(id),
+			MapColor:     data.GetMapColor(id),
 		}
 	}
 
 	return writeJSON(filepath.Join(versionDir, "blocks.json"), blocks)
 }
 
+// attributeOperations maps attribute operations to their JSON names
+var attributeOperations = map[inventory.AttributeOperation]string{
+	inventory.AttributeAddValue:           "add_value",
+	inventory.AttributeAddMultipliedBase:  "add_multiplied_base",
+	inventory.AttributeAddMultipliedTotal: "add_multiplied_total",
+}
+
 func extractItems(versionDir string) error {
 	items := make(map[string]ItemData)
 
 	for name, id := range data.ItemNameToID {
-		items[name] = ItemData{
-			ID:         id,
-			Name:       name,
-			StackSize:  data.GetMaxStackSize(id),
-			Durability: data.GetMaxDurability(id),
+		item := ItemData{
+			ID:            id,
+			Name:          name,
+			StackSize:     data.GetMaxStackSize(id),
+			Durability:    data.GetMaxDurability(id),
+			Equippable:    data.GetEquippableSlot(id),
+			FireResistant: data.IsFireResistant(id),
+			Enchantable:   data.GetEnchantable(id),
+			Repairable:    data.GetRepairable(id),
+		}
+		if rarity := data.GetRarity(id); rarity != inventory.RarityCommon {
+			item.Rarity = string(rarity)
+		}
+		if food, ok := data.GetFood(id); ok {
+			item.Food = &FoodData{Nutrition: food.Nutrition, Saturation: food.Saturation, CanAlwaysEat: food.CanAlwaysEat}
 		}
+		if tool := data.GetTool(id); tool != nil {
+			item.Tool = &ToolData{DefaultMiningSpeed: tool.DefaultMiningSpeed, DamagePerBlock: tool.DamagePerBlock, Rules: []ToolRuleData{}}
+			for _, rule := range tool.Rules {
+				item.Tool.Rules = append(item.Tool.Rules, ToolRuleData{Blocks: rule.Blocks, Speed: rule.Speed, CorrectForDrops: rule.CorrectForDrops})
+			}
+		}
+		for _, modifier := range data.GetAttributeModifiers(id) {
+			item.AttributeModifiers = append(item.AttributeModifiers, AttributeModifierData{
+				Type:      modifier.Type,
+				ID:        modifier.ID,
+				Amount:    modifier.Amount,
+				Operation: attributeOperations[modifier.Operation],
+				Slot:      modifier.Slot,
+			})
+		}
+		items[name] = item
 	}
 
 	return writeJSON(filepath.Join(versionDir, "items.json"), items)
@@ -115,6 +285,125 @@ func extractEntities(versionDir string) error {
 	return writeJSON(filepath.Join(versionDir, "entities.json"), entities)
 }
 
+func extractRecipes(versionDir string) error {
+	recipes := make([]RecipeData, 0, len(data.Recipes))
+
+	for _, r := range data.Recipes {
+		recipe := RecipeData{
+			ID:          r.ID,
+			Type:        string(r.Type),
+			Category:    r.Category,
+			Group:       r.Group,
+			Template:    r.Template,
+			Base:        r.Base,
+			Addition:    r.Addition,
+			Experience:  r.Experience,
+			CookingTime: r.CookingTime,
+		}
+		for _, ing := range r.Ingredients {
+			recipe.Ingredients = append(recipe.Ingredients, ing)
+		}
+		if r.Pattern != nil {
+			// Rebuild the pattern, assigning a key character to each distinct ingredient
+			recipe.Key = make(map[string][]string)
+			chars := make(map[string]string)
+			for y := 0; y < r.Height; y++ {
+				row := ""
+				for x := 0; x < r.Width; x++ {
+					cell := r.Pattern[y*r.Width+x]
+					if cell == nil {
+						row += " "
+						continue
+					}
+					id := strings.Join(cell, ",")
+					char, ok := chars[id]
+					if !ok {
+						char = string(rune('A' + len(chars)))
+						chars[id] = char
+						recipe.Key[char] = cell
+					}
+					row += char
+				}
+				recipe.Pattern = append(recipe.Pattern, row)
+			}
+		}
+		if r.Result.Item != "" {
+			recipe.Result = &RecipeResultData{Item: r.Result.Item, Count: r.Result.Count}
+		}
+		recipes = append(recipes, recipe)
+	}
+
+	return writeJSON(filepath.Join(versionDir, "recipes.json"), recipes)
+}
+
+func extractEnchantments(versionDir string) error {
+	enchantments := make(map[string]EnchantmentData)
+
+	for _, e := range data.Enchantments {
+		name := strings.TrimPrefix(e.Name, "minecraft:")
+		enchantments[name] = EnchantmentData{
+			ID:             e.ID,
+			Name:           name,
+			MaxLevel:       e.MaxLevel,
+			Weight:         e.Weight,
+			AnvilCost:      e.AnvilCost,
+			MinCost:        EnchantCostData{Base: e.MinCost.Base, PerLevel: e.MinCost.PerLevel},
+			MaxCost:        EnchantCostData{Base: e.MaxCost.Base, PerLevel: e.MaxCost.PerLevel},
+			Slots:          e.Slots,
+			SupportedItems: e.SupportedItems,
+			PrimaryItems:   e.PrimaryItems,
+			ExclusiveSet:   e.ExclusiveSet,
+			Treasure:       e.Treasure,
+			Curse:          e.Curse,
+		}
+	}
+
+	return writeJSON(filepath.Join(versionDir, "enchantments.json"), enchantments)
+}
+
+func extractAttributes(versionDir string) error {
+	attributes := make(map[string]AttributeData)
+
+	for _, a := range data.Attributes {
+		name := strings.TrimPrefix(a.Name, "minecraft:")
+		attributes[name] = AttributeData{
+			ID:      a.ID,
+			Name:    name,
+			Default: a.Default,
+			Min:     a.Min,
+			Max:     a.Max,
+		}
+	}
+
+	return writeJSON(filepath.Join(versionDir, "attributes.json"), attributes)
+}
+
+func extractEffects(versionDir string) error {
+	effects := make(map[string]EffectData)
+
+	for _, e := range data.Effects {
+		name := strings.TrimPrefix(e.Name, "minecraft:")
+		effects[name] = EffectData{
+			ID:       e.ID,
+			Name:     name,
+			Category: string(e.Category),
+			Color:    e.Color,
+		}
+	}
+
+	return writeJSON(filepath.Join(versionDir, "effects.json"), effects)
+}
+
+func extractTags(versionDir string) error {
+	tags := make(TagsData)
+
+	for kind, defs := range data.VanillaTags {
+		tags[string(kind)] = defs
+	}
+
+	return writeJSON(filepath.Join(versionDir, "tags.json"), tags)
+}
+
 func writeJSON(filename string, data interface{}) error {
 	file, err := os.Create(filename)
 	if err != nil {
@@ -9,24 +9,133 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
+	"strings"
 	"text/template"
 )
 
 // BlockData represents a block in JSON format
 type BlockData struct {
-	ID       int     `json:"id"`
-	Name     string  `json:"name"`
-	Solid    bool    `json:"solid"`
-	Hardness float64 `json:"hardness"`
+	ID           int     `json:"id"`
+	Name         string  `json:"name"`
+	Solid        bool    `json:"solid"`
+	Hardness     float64 `json:"hardness"`
+	RequiresTool bool    `json:"requiresTool"`
+	Resistance   float64 `json:"resistance"`
+	MapColor     int     `json:"mapColor"`
 }
 
 // ItemData represents an item in JSON format
 type ItemData struct {
-	ID         int    `json:"id"`
-	Name       string `json:"name"`
-	StackSize  int    `json:"stackSize"`
-	Durability int    `json:"durability"`
+	ID                 int                     `json:"id"`
+	Name               string                  `json:"name"`
+	StackSize          int                     `json:"stackSize"`
+	Durability         int                     `json:"durability"`
+	Food               *FoodData               `json:"food,omitempty"`
+	Tool               *ToolData               `json:"tool,omitempty"`
+	Equippable         string                  `json:"equippable,omitempty"`
+	Rarity             string                  `json:"rarity,omitempty"`
+	FireResistant      bool                    `json:"fireResistant,omitempty"`
+	Enchantable        int                     `json:"enchantable,omitempty"`
+	Repairable         string                  `json:"repairable,omitempty"`
+	AttributeModifiers []AttributeModifierData `json:"attributeModifiers,omitempty"`
+}
+
+// FoodData represents an item's food properties in JSON format
+type FoodData struct {
+	Nutrition    int     `json:"nutrition"`
+	Saturation   float64 `json:"saturation"`
+	CanAlwaysEat bool    `json:"canAlwaysEat"`
+}
+
+// ToolData represents an item's tool rules in JSON format
+type ToolData struct {
+	Rules              []ToolRuleData `json:"rules"`
+	DefaultMiningSpeed float64        `json:"defaultMiningSpeed"`
+	DamagePerBlock     int            `json:"damagePerBlock"`
+}
+
+// AttributeModifierData represents an item's attribute modifier in JSON format
+type AttributeModifierData struct {
+	Type      string  `json:"type"`
+	ID        string  `json:"id"`
+	Amount    float64 `json:"amount"`
+	Operation string  `json:"operation"`
+	Slot      string  `json:"slot"`
+}
+
+// ToolRuleData represents a single tool rule in JSON format
+type ToolRuleData struct {
+	Blocks          []string `json:"blocks"`
+	Speed           *float64 `json:"speed,omitempty"`
+	CorrectForDrops *bool    `json:"correctForDrops,omitempty"`
+}
+
+// RecipeData represents a recipe in JSON format
+type RecipeData struct {
+	ID          string              `json:"id"`
+	Type        string              `json:"type"`
+	Category    string              `json:"category,omitempty"`
+	Group       string              `json:"group,omitempty"`
+	Pattern     []string            `json:"pattern,omitempty"`
+	Key         map[string][]string `json:"key,omitempty"`
+	Ingredients [][]string          `json:"ingredients,omitempty"`
+	Template    []string            `json:"template,omitempty"`
+	Base        []string            `json:"base,omitempty"`
+	Addition    []string            `json:"addition,omitempty"`
+	Result      *RecipeResultData   `json:"result,omitempty"`
+	Experience  float64             `json:"experience,omitempty"`
+	CookingTime int                 `json:"cookingTime,omitempty"`
+}
+
+// RecipeResultData represents a recipe result in JSON format
+type RecipeResultData struct {
+	Item  string `json:"item"`
+	Count int    `json:"count"`
+}
+
+// TagsData holds tag definitions by registry (block, item, entity_type, fluid) in JSON format
+type TagsData map[string]map[string][]string
+
+// EnchantmentData represents an enchantment in JSON format
+type EnchantmentData struct {
+	ID             int             `json:"id"`
+	Name           string          `json:"name"`
+	MaxLevel       int             `json:"maxLevel"`
+	Weight         int             `json:"weight"`
+	AnvilCost      int             `json:"anvilCost"`
+	MinCost        EnchantCostData `json:"minCost"`
+	MaxCost        EnchantCostData `json:"maxCost"`
+	Slots          []string        `json:"slots"`
+	SupportedItems string          `json:"supportedItems"`
+	PrimaryItems   string          `json:"primaryItems,omitempty"`
+	ExclusiveSet   []string        `json:"exclusiveSet,omitempty"`
+	Treasure       bool            `json:"treasure,omitempty"`
+	Curse          bool            `json:"curse,omitempty"`
+}
+
+// AttributeData represents an attribute type in JSON format
+type AttributeData struct {
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Default float64 `json:"default"`
+	Min     float64 `json:"min"`
+	Max     float64 `json:"max"`
+}
+
+// EffectData represents a status effect in JSON format
+type EffectData struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category"`
+	Color    int    `json:"color"`
+}
+
+// EnchantCostData represents an enchanting cost in JSON format
+type EnchantCostData struct {
+	Base     int `json:"base"`
+	PerLevel int `json:"perLevel"`
 }
 
 // EntityData represents an entity in JSON format
@@ -63,6 +172,31 @@ var HardnessMap = map[int]float64{
 {{- end }}
 }
 
+// RequiresToolMap stores the blocks that only drop when mined with a correct tool (Minecraft {{ .Version }})
+var RequiresToolMap = map[int]bool{
+{{- range $name, $block := .Blocks }}
+	{{- if $block.RequiresTool }}
+	{{ $block.ID }}: true, // {{ $block.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// ResistanceMap stores block blast resistance values (Minecraft {{ .Version }})
+var ResistanceMap = map[int]float64{
+{{- range $name, $block := .Blocks }}
+	{{ $block.ID }}: {{ printf "%g" $block.Resistance }}, // {{ $block.Name }}
+{{- end }}
+}
+
+// MapColorMap stores the map color ID of each block (Minecraft {{ .Version }})
+var MapColorMap = map[int]int{
+{{- range $name, $block := .Blocks }}
+	{{- if $block.MapColor }}
+	{{ $block.ID }}: {{ $block.MapColor }}, // {{ $block.Name }}
+	{{- end }}
+{{- end }}
+}
+
 // IsSolid checks if a block ID is solid
 func IsSolid(id int) bool {
 	return IsSolidMap[id]
@@ -75,11 +209,28 @@ func GetHardness(id int) float64 {
 	}
 	return -1.0
 }
+
+// RequiresTool checks if a block only drops when mined with a correct tool
+func RequiresTool(id int) bool {
+	return RequiresToolMap[id]
+}
+
+// GetBlastResistance returns the blast resistance of a block
+func GetBlastResistance(id int) float64 {
+	return ResistanceMap[id]
+}
+
+// GetMapColor returns the map color ID of a block (0 if it is not drawn on maps)
+func GetMapColor(id int) int {
+	return MapColorMap[id]
+}
 `
 
 var itemsTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
 package data
 
+import "github.com/konjacbot/prismarine-go/inventory"
+
 // ItemNameToID maps item names to their numeric IDs (Minecraft {{ .Version }})
 var ItemNameToID = map[string]int{
 {{- range $name, $item := .Items }}
@@ -103,6 +254,92 @@ var DurabilityMap = map[int]int{
 {{- end }}
 }
 
+// FoodMap stores the food properties of edible items (Minecraft {{ .Version }})
+var FoodMap = map[int]inventory.Food{
+{{- range $name, $item := .Items }}
+	{{- with $item.Food }}
+	{{ $item.ID }}: {Nutrition: {{ .Nutrition }}, Saturation: {{ printf "%g" .Saturation }}, CanAlwaysEat: {{ .CanAlwaysEat }}}, // {{ $item.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// ToolMap stores the mining rules of tools (Minecraft {{ .Version }})
+var ToolMap = map[int]*inventory.Tool{
+{{- range $name, $item := .Items }}
+	{{- with $item.Tool }}
+	{{ $item.ID }}: { // {{ $item.Name }}
+		Rules: []inventory.ToolRule{
+		{{- range .Rules }}
+			{Blocks: {{ printf "%#v" .Blocks }}
+			{{- with .Speed }}, Speed: floatPtr({{ printf "%g" (deref .) }}){{ end }}
+			{{- with .CorrectForDrops }}, CorrectForDrops: boolPtr({{ deref . }}){{ end }}},
+		{{- end }}
+		},
+		DefaultMiningSpeed: {{ printf "%g" .DefaultMiningSpeed }},
+		DamagePerBlock:     {{ .DamagePerBlock }},
+	},
+	{{- end }}
+{{- end }}
+}
+
+// EquippableMap stores the equipment slot of wearable items (Minecraft {{ .Version }})
+var EquippableMap = map[int]string{
+{{- range $name, $item := .Items }}
+	{{- if $item.Equippable }}
+	{{ $item.ID }}: "{{ $item.Equippable }}", // {{ $item.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// RarityMap stores the rarity of items that are not common (Minecraft {{ .Version }})
+var RarityMap = map[int]inventory.Rarity{
+{{- range $name, $item := .Items }}
+	{{- if $item.Rarity }}
+	{{ $item.ID }}: "{{ $item.Rarity }}", // {{ $item.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// FireResistantMap stores items that survive fire and lava (Minecraft {{ .Version }})
+var FireResistantMap = map[int]bool{
+{{- range $name, $item := .Items }}
+	{{- if $item.FireResistant }}
+	{{ $item.ID }}: true, // {{ $item.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// EnchantableMap stores the enchanting table value of enchantable items (Minecraft {{ .Version }})
+var EnchantableMap = map[int]int{
+{{- range $name, $item := .Items }}
+	{{- if $item.Enchantable }}
+	{{ $item.ID }}: {{ $item.Enchantable }}, // {{ $item.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// RepairableMap stores the item or tag that repairs each item in an anvil (Minecraft {{ .Version }})
+var RepairableMap = map[int]string{
+{{- range $name, $item := .Items }}
+	{{- if $item.Repairable }}
+	{{ $item.ID }}: "{{ $item.Repairable }}", // {{ $item.Name }}
+	{{- end }}
+{{- end }}
+}
+
+// AttributeModifierMap stores the attribute modifiers of armor and weapons (Minecraft {{ .Version }})
+var AttributeModifierMap = map[int][]inventory.AttributeModifier{
+{{- range $name, $item := .Items }}
+	{{- if $item.AttributeModifiers }}
+	{{ $item.ID }}: { // {{ $item.Name }}
+	{{- range $item.AttributeModifiers }}
+		{Type: "{{ .Type }}", ID: "{{ .ID }}", Amount: {{ printf "%g" .Amount }}, Operation: {{ operation .Operation }}, Slot: "{{ .Slot }}"},
+	{{- end }}
+	},
+	{{- end }}
+{{- end }}
+}
+
 // GetMaxStackSize returns the maximum stack size for an item
 func GetMaxStackSize(id int) int {
 	if size, ok := StackSizeMap[id]; ok {
@@ -118,6 +355,58 @@ func GetMaxDurability(id int) int {
 	}
 	return 0 // No durability
 }
+
+// GetFood returns the food properties of an item
+func GetFood(id int) (inventory.Food, bool) {
+	food, ok := FoodMap[id]
+	return food, ok
+}
+
+// GetTool returns the mining rules of a tool (nil if the item is not a tool)
+func GetTool(id int) *inventory.Tool {
+	return ToolMap[id]
+}
+
+// GetEquippableSlot returns the equipment slot of an item (empty if it cannot be worn)
+func GetEquippableSlot(id int) string {
+	return EquippableMap[id]
+}
+
+// GetRarity returns the rarity of an item
+func GetRarity(id int) inventory.Rarity {
+	if rarity, ok := RarityMap[id]; ok {
+		return rarity
+	}
+	return inventory.RarityCommon
+}
+
+// IsFireResistant checks if an item survives fire and lava
+func IsFireResistant(id int) bool {
+	return FireResistantMap[id]
+}
+
+// GetEnchantable returns the enchanting table value of an item (0 if not enchantable)
+func GetEnchantable(id int) int {
+	return EnchantableMap[id]
+}
+
+// GetRepairable returns the item or tag that repairs an item (empty if not repairable)
+func GetRepairable(id int) string {
+	return RepairableMap[id]
+}
+
+// GetAttributeModifiers returns the attribute modifiers of an item (nil if it has none)
+func GetAttributeModifiers(id int) []inventory.AttributeModifier {
+	return AttributeModifierMap[id]
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func boolPtr(v bool) *bool {
+	return &v
+}
 `
 
 var entitiesTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
@@ -138,6 +427,146 @@ var EntityIDToName = map[int32]string{
 }
 `
 
+var recipesTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
+package data
+
+import "github.com/konjacbot/prismarine-go/inventory"
+
+// Recipes lists all recipes (Minecraft {{ .Version }})
+var Recipes = []inventory.Recipe{
+{{- range .Recipes }}
+	{
+		ID:   "{{ .ID }}",
+		Type: "{{ .Type }}",
+		{{- if .Category }}
+		Category: "{{ .Category }}",
+		{{- end }}
+		{{- if .Group }}
+		Group: "{{ .Group }}",
+		{{- end }}
+		{{- if .Pattern }}
+		Width:   {{ width .Pattern }},
+		Height:  {{ len .Pattern }},
+		Pattern: []inventory.Ingredient{ {{- range cells .Pattern .Key }}{{ ingredient . }}, {{ end -}} },
+		{{- end }}
+		{{- if .Ingredients }}
+		Ingredients: []inventory.Ingredient{ {{- range .Ingredients }}{{ ingredient . }}, {{ end -}} },
+		{{- end }}
+		{{- if .Template }}
+		Template: inventory.Ingredient{{ ingredient .Template }},
+		Base:     inventory.Ingredient{{ ingredient .Base }},
+		Addition: inventory.Ingredient{{ ingredient .Addition }},
+		{{- end }}
+		{{- with .Result }}
+		Result: inventory.RecipeResult{Item: "{{ .Item }}", Count: {{ .Count }}},
+		{{- end }}
+		{{- if .CookingTime }}
+		Experience:  {{ printf "%g" .Experience }},
+		CookingTime: {{ .CookingTime }},
+		{{- end }}
+	},
+{{- end }}
+}
+`
+
+var tagsTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
+package data
+
+// VanillaTags lists tag definitions by registry (Minecraft {{ .Version }}).
+// Entries are names or nested tag references ("#minecraft:logs").
+var VanillaTags = map[TagType]map[string][]string{
+{{- range $kind, $tags := .Tags }}
+	"{{ $kind }}": {
+	{{- range $name, $entries := $tags }}
+		"{{ $name }}": { {{- range $i, $e := $entries }}{{ if $i }}, {{ end }}"{{ $e }}"{{ end -}} },
+	{{- end }}
+	},
+{{- end }}
+}
+`
+
+var attributesTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
+package data
+
+import "github.com/konjacbot/prismarine-go/entity"
+
+// AttributeNameToID maps attribute names to their registry IDs (Minecraft {{ .Version }})
+var AttributeNameToID = map[string]int{
+{{- range .Attributes }}
+	"{{ .Name }}": {{ .ID }},
+{{- end }}
+}
+
+// Attributes lists all attributes by registry ID (Minecraft {{ .Version }})
+var Attributes = []entity.AttributeInfo{
+{{- range .Attributes }}
+	{ID: {{ .ID }}, Name: "minecraft:{{ .Name }}", Default: {{ printf "%g" .Default }}, Min: {{ printf "%g" .Min }}, Max: {{ printf "%g" .Max }}},
+{{- end }}
+}
+`
+
+var effectsTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
+package data
+
+import "github.com/konjacbot/prismarine-go/entity"
+
+// EffectNameToID maps status effect names to their registry IDs (Minecraft {{ .Version }})
+var EffectNameToID = map[string]int{
+{{- range .Effects }}
+	"{{ .Name }}": {{ .ID }},
+{{- end }}
+}
+
+// Effects lists all status effects by registry ID (Minecraft {{ .Version }})
+var Effects = []entity.EffectInfo{
+{{- range .Effects }}
+	{ID: {{ .ID }}, Name: "minecraft:{{ .Name }}", Category: "{{ .Category }}", Color: {{ printf "0x%06X" .Color }}},
+{{- end }}
+}
+`
+
+var enchantmentsTemplate = `// Code generated by tools/generator.go from JSON data; DO NOT EDIT.
+package data
+
+import "github.com/konjacbot/prismarine-go/inventory"
+
+// EnchantmentNameToID maps enchantment names to their registry IDs (Minecraft {{ .Version }})
+var EnchantmentNameToID = map[string]int{
+{{- range .Enchantments }}
+	"{{ .Name }}": {{ .ID }},
+{{- end }}
+}
+
+// Enchantments lists all enchantments by registry ID (Minecraft {{ .Version }})
+var Enchantments = []inventory.EnchantmentInfo{
+{{- range .Enchantments }}
+	{
+		ID:        {{ .ID }},
+		Name:      "minecraft:{{ .Name }}",
+		MaxLevel:  {{ .MaxLevel }},
+		Weight:    {{ .Weight }},
+		AnvilCost: {{ .AnvilCost }},
+		MinCost:   inventory.EnchantCost{Base: {{ .MinCost.Base }}, PerLevel: {{ .MinCost.PerLevel }}},
+		MaxCost:   inventory.EnchantCost{Base: {{ .MaxCost.Base }}, PerLevel: {{ .MaxCost.PerLevel }}},
+		Slots:     []string{ {{- range $i, $s := .Slots }}{{ if $i }}, {{ end }}"{{ $s }}"{{ end -}} },
+		SupportedItems: "{{ .SupportedItems }}",
+		{{- if .PrimaryItems }}
+		PrimaryItems: "{{ .PrimaryItems }}",
+		{{- end }}
+		{{- if .ExclusiveSet }}
+		ExclusiveSet: []string{ {{- range $i, $e := .ExclusiveSet }}{{ if $i }}, {{ end }}"{{ $e }}"{{ end -}} },
+		{{- end }}
+		{{- if .Treasure }}
+		Treasure: true,
+		{{- end }}
+		{{- if .Curse }}
+		Curse: true,
+		{{- end }}
+	},
+{{- end }}
+}
+`
+
 func main() {
 	// For now, generate for 1.21.10 (can be extended for multi-version)
 	version := "1.21.10"
@@ -172,6 +601,36 @@ func main() {
 		os.Exit(1)
 	}
 
+	// Generate recipes.go
+	if err := generateRecipes(versionDir, version, baseDir); err != nil {
+		fmt.Printf("❌ Error generating recipes: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Generate enchantments.go
+	if err := generateEnchantments(versionDir, version, baseDir); err != nil {
+		fmt.Printf("❌ Error generating enchantments: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Generate attributes.go
+	if err := generateAttributes(versionDir, version, baseDir); err != nil {
+		fmt.Printf("❌ Error generating attributes: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Generate effects.go
+	if err := generateEffects(versionDir, version, baseDir); err != nil {
+		fmt.Printf("❌ Error generating effects: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Generate tags.go
+	if err := generateTags(versionDir, version, baseDir); err != nil {
+		fmt.Printf("❌ Error generating tags: %v\n", err)
+		os.Exit(1)
+	}
+
 	fmt.Println("✅ Code generation complete!")
 }
 
@@ -250,7 +709,22 @@ func generateItems(versionDir, version, baseDir string) error {
 	}
 
 	// Generate code
-	tmpl, err := template.New("items").Parse(itemsTemplate)
+	tmpl, err := template.New("items").Funcs(template.FuncMap{
+		"deref": func(v interface{}) interface{} {
+			return reflect.Indirect(reflect.ValueOf(v)).Interface()
+		},
+		"operation": func(op string) (string, error) {
+			switch op {
+			case "add_value":
+				return "inventory.AttributeAddValue", nil
+			case "add_multiplied_base":
+				return "inventory.AttributeAddMultipliedBase", nil
+			case "add_multiplied_total":
+				return "inventory.AttributeAddMultipliedTotal", nil
+			}
+			return "", fmt.Errorf("unknown attribute operation %q", op)
+		},
+	}).Parse(itemsTemplate)
 	if err != nil {
 		return err
 	}
@@ -322,3 +796,248 @@ func generateEntities(versionDir, version, baseDir string) error {
 		"Entities": sortedEntities,
 	})
 }
+
+func generateRecipes(versionDir, version, baseDir string) error {
+	// Read JSON
+	data, err := os.ReadFile(filepath.Join(versionDir, "recipes.json"))
+	if err != nil {
+		return err
+	}
+
+	var recipes []RecipeData
+	if err := json.Unmarshal(data, &recipes); err != nil {
+		return err
+	}
+
+	// Sort by ID
+	sort.Slice(recipes, func(i, j int) bool { return recipes[i].ID < recipes[j].ID })
+
+	// Generate code
+	tmpl, err := template.New("recipes").Funcs(template.FuncMap{
+		"width": func(pattern []string) int {
+			width := 0
+			for _, row := range pattern {
+				width = max(width, len(row))
+			}
+			return width
+		},
+		// cells expands a pattern into row-major ingredient cells (nil for spaces)
+		"cells": func(pattern []string, key map[string][]string) [][]string {
+			width := 0
+			for _, row := range pattern {
+				width = max(width, len(row))
+			}
+			var cells [][]string
+			for _, row := range pattern {
+				for x := 0; x < width; x++ {
+					if x < len(row) && row[x] != ' ' {
+						cells = append(cells, key[string(row[x])])
+					} else {
+						cells = append(cells, nil)
+					}
+				}
+			}
+			return cells
+		},
+		"ingredient": func(entries []string) string {
+			if entries == nil {
+				return "nil"
+			}
+			quoted := make([]string, len(entries))
+			for i, entry := range entries {
+				quoted[i] = fmt.Sprintf("%q", entry)
+			}
+			return "{" + strings.Join(quoted, ", ") + "}"
+		},
+	}).Parse(recipesTemplate)
+	if err != nil {
+		return err
+	}
+
+	outputFile := filepath.Join(baseDir, "recipes.go")
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			fmt.Printf("❌ Error closing file: %v\n", err)
+			os.Exit(1)
+		}
+	}(file)
+
+	return tmpl.Execute(file, map[string]interface{}{
+		"Version": version,
+		"Recipes": recipes,
+	})
+}
+
+func generateTags(versionDir, version, baseDir string) error {
+	// Read JSON
+	data, err := os.ReadFile(filepath.Join(versionDir, "tags.json"))
+	if err != nil {
+		return err
+	}
+
+	var tags TagsData
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return err
+	}
+
+	// Generate code (text/template ranges over maps in key order)
+	tmpl, err := template.New("tags").Parse(tagsTemplate)
+	if err != nil {
+		return err
+	}
+
+	outputFile := filepath.Join(baseDir, "tags.go")
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			fmt.Printf("❌ Error closing file: %v\n", err)
+			os.Exit(1)
+		}
+	}(file)
+
+	return tmpl.Execute(file, map[string]interface{}{
+		"Version": version,
+		"Tags":    tags,
+	})
+}
+
+func generateEnchantments(versionDir, version, baseDir string) error {
+	// Read JSON
+	data, err := os.ReadFile(filepath.Join(versionDir, "enchantments.json"))
+	if err != nil {
+		return err
+	}
+
+	var byName map[string]EnchantmentData
+	if err := json.Unmarshal(data, &byName); err != nil {
+		return err
+	}
+
+	// Sort by registry ID
+	enchantments := make([]EnchantmentData, 0, len(byName))
+	for _, e := range byName {
+		enchantments = append(enchantments, e)
+	}
+	sort.Slice(enchantments, func(i, j int) bool { return enchantments[i].ID < enchantments[j].ID })
+
+	// Generate code
+	tmpl, err := template.New("enchantments").Parse(enchantmentsTemplate)
+	if err != nil {
+		return err
+	}
+
+	outputFile := filepath.Join(baseDir, "enchantments.go")
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			fmt.Printf("❌ Error closing file: %v\n", err)
+			os.Exit(1)
+		}
+	}(file)
+
+	return tmpl.Execute(file, map[string]interface{}{
+		"Version":      version,
+		"Enchantments": enchantments,
+	})
+}
+
+func generateAttributes(versionDir, version, baseDir string) error {
+	// Read JSON
+	data, err := os.ReadFile(filepath.Join(versionDir, "attributes.json"))
+	if err != nil {
+		return err
+	}
+
+	var byName map[string]AttributeData
+	if err := json.Unmarshal(data, &byName); err != nil {
+		return err
+	}
+
+	// Sort by registry ID
+	attributes := make([]AttributeData, 0, len(byName))
+	for _, a := range byName {
+		attributes = append(attributes, a)
+	}
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].ID < attributes[j].ID })
+
+	// Generate code
+	tmpl, err := template.New("attributes").Parse(attributesTemplate)
+	if err != nil {
+		return err
+	}
+
+	outputFile := filepath.Join(baseDir, "attributes.go")
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			fmt.Printf("❌ Error closing file: %v\n", err)
+			os.Exit(1)
+		}
+	}(file)
+
+	return tmpl.Execute(file, map[string]interface{}{
+		"Version":    version,
+		"Attributes": attributes,
+	})
+}
+
+func generateEffects(versionDir, version, baseDir string) error {
+	// Read JSON
+	data, err := os.ReadFile(filepath.Join(versionDir, "effects.json"))
+	if err != nil {
+		return err
+	}
+
+	var byName map[string]EffectData
+	if err := json.Unmarshal(data, &byName); err != nil {
+		return err
+	}
+
+	// Sort by registry ID
+	effects := make([]EffectData, 0, len(byName))
+	for _, e := range byName {
+		effects = append(effects, e)
+	}
+	sort.Slice(effects, func(i, j int) bool { return effects[i].ID < effects[j].ID })
+
+	// Generate code
+	tmpl, err := template.New("effects").Parse(effectsTemplate)
+	if err != nil {
+		return err
+	}
+
+	outputFile := filepath.Join(baseDir, "effects.go")
+	file, err := os.Create(outputFile)
+	if err != nil {
+		return err
+	}
+	defer func(file *os.File) {
+		err := file.Close()
+		if err != nil {
+			fmt.Printf("❌ Error closing file: %v\n", err)
+			os.Exit(1)
+		}
+	}(file)
+
+	return tmpl.Execute(file, map[string]interface{}{
+		"Version": version,
+		"Effects": effects,
+	})
+}
@@ -0,0 +1,173 @@
+//go:build ignore
+
+// langfetch.go - Downloads the vanilla language files for every data version
+// Usage: go run langfetch.go [locale...]   (default: en_us zh_tw zh_cn)
+//
+// en_us comes from the client jar, the other locales from the version's asset
+// index. Files are written to minecraft_data/<version>/lang/<locale>.json and
+// embedded by lang.go.
+package main
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	manifestURL  = "https://piston-meta.mojang.com/mc/game/version_manifest_v2.json"
+	resourcesURL = "https://resources.download.minecraft.net"
+)
+
+// versionManifest lists every released version
+type versionManifest struct {
+	Versions []struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	} `json:"versions"`
+}
+
+// versionInfo holds the parts of a version JSON needed for language files
+type versionInfo struct {
+	AssetIndex struct {
+		URL string `json:"url"`
+	} `json:"assetIndex"`
+	Downloads struct {
+		Client struct {
+			URL string `json:"url"`
+		} `json:"client"`
+	} `json:"downloads"`
+}
+
+// assetIndex maps asset paths to their content hashes
+type assetIndex struct {
+	Objects map[string]struct {
+		Hash string `json:"hash"`
+	} `json:"objects"`
+}
+
+func main() {
+	versions := []string{"1.21.0", "1.21.4", "1.21.8", "1.21.10"}
+	locales := []string{"en_us", "zh_tw", "zh_cn"}
+	if len(os.Args) > 1 {
+		locales = os.Args[1:]
+	}
+
+	// Works both from data/ and from data/tools/
+	baseDir := "."
+	if _, err := os.Stat("minecraft_data"); os.IsNotExist(err) {
+		baseDir = ".."
+	}
+
+	var manifest versionManifest
+	if err := getJSON(manifestURL, &manifest); err != nil {
+		fmt.Printf("❌ Error reading version manifest: %v\n", err)
+		os.Exit(1)
+	}
+	urls := make(map[string]string, len(manifest.Versions))
+	for _, v := range manifest.Versions {
+		urls[v.ID] = v.URL
+	}
+
+	failed := false
+	for _, version := range versions {
+		fmt.Printf("Fetching language files for Minecraft %s...\n", version)
+		// Mojang names the first release of a minor version without ".0"
+		url, ok := urls[strings.TrimSuffix(version, ".0")]
+		if !ok {
+			fmt.Printf("❌ Version %s not in manifest\n", version)
+			failed = true
+			continue
+		}
+		dir := filepath.Join(baseDir, "minecraft_data", version, "lang")
+		if err := fetchVersion(url, dir, locales); err != nil {
+			fmt.Printf("❌ Error fetching %s: %v\n", version, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+	fmt.Println("✅ Language files updated!")
+}
+
+// fetchVersion writes the requested locales of one version to dir
+func fetchVersion(url, dir string, locales []string) error {
+	var info versionInfo
+	if err := getJSON(url, &info); err != nil {
+		return err
+	}
+	var index assetIndex
+	if err := getJSON(info.AssetIndex.URL, &index); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	for _, locale := range locales {
+		var raw []byte
+		var err error
+		if locale == "en_us" {
+			raw, err = clientLang(info.Downloads.Client.URL)
+		} else {
+			object, ok := index.Objects["minecraft/lang/"+locale+".json"]
+			if !ok {
+				return fmt.Errorf("unknown locale %s", locale)
+			}
+			raw, err = get(fmt.Sprintf("%s/%s/%s", resourcesURL, object.Hash[:2], object.Hash))
+		}
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, locale+".json"), raw, 0644); err != nil {
+			return err
+		}
+		fmt.Printf("  %s\n", locale)
+	}
+	return nil
+}
+
+// clientLang extracts en_us.json from the client jar
+func clientLang(url string) ([]byte, error) {
+	jar, err := get(url)
+	if err != nil {
+		return nil, err
+	}
+	zr, err := zip.NewReader(bytes.NewReader(jar), int64(len(jar)))
+	if err != nil {
+		return nil, err
+	}
+	f, err := zr.Open("assets/minecraft/lang/en_us.json")
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func getJSON(url string, v any) error {
+	raw, err := get(url)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+func get(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,349 @@
+package entity
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/inventory"
+)
+
+// Attribute names (1.21.2+ registry names, without the "generic." prefix)
+const (
+	AttributeArmor                   = "minecraft:armor"
+	AttributeArmorToughness          = "minecraft:armor_toughness"
+	AttributeAttackDamage            = "minecraft:attack_damage"
+	AttributeAttackKnockback         = "minecraft:attack_knockback"
+	AttributeAttackSpeed             = "minecraft:attack_speed"
+	AttributeBlockBreakSpeed         = "minecraft:block_break_speed"
+	AttributeBlockInteractionRange   = "minecraft:block_interaction_range"
+	AttributeEntityInteractionRange  = "minecraft:entity_interaction_range"
+	AttributeFallDamageMultiplier    = "minecraft:fall_damage_multiplier"
+	AttributeGravity                 = "minecraft:gravity"
+	AttributeJumpStrength            = "minecraft:jump_strength"
+	AttributeKnockbackResistance     = "minecraft:knockback_resistance"
+	AttributeMaxAbsorption           = "minecraft:max_absorption"
+	AttributeMaxHealth               = "minecraft:max_health"
+	AttributeMiningEfficiency        = "minecraft:mining_efficiency"
+	AttributeMovementEfficiency      = "minecraft:movement_efficiency"
+	AttributeMovementSpeed           = "minecraft:movement_speed"
+	AttributeOxygenBonus             = "minecraft:oxygen_bonus"
+	AttributeSafeFallDistance        = "minecraft:safe_fall_distance"
+	AttributeScale                   = "minecraft:scale"
+	AttributeSneakingSpeed           = "minecraft:sneaking_speed"
+	AttributeStepHeight              = "minecraft:step_height"
+	AttributeSubmergedMiningSpeed    = "minecraft:submerged_mining_speed"
+	AttributeWaterMovementEfficiency = "minecraft:water_movement_efficiency"
+)
+
+// AttributeInfo describes an attribute type
+type AttributeInfo struct {
+	ID      int     // Registry ID (sent in Update Attributes)
+	Name    string  // Attribute name (e.g. "minecraft:movement_speed")
+	Default float64 // Default base value
+	Min     float64 // Lowest computed value
+	Max     float64 // Highest computed value
+}
+
+// DefaultAttributes holds the vanilla defaults of the common attributes, for use
+// without a registry (data.Registry provides the full list for each version)
+var DefaultAttributes = []AttributeInfo{
+	{ID: 0, Name: AttributeArmor, Default: 0, Min: 0, Max: 30},
+	{ID: 1, Name: AttributeArmorToughness, Default: 0, Min: 0, Max: 20},
+	{ID: 2, Name: AttributeAttackDamage, Default: 2, Min: 0, Max: 2048},
+	{ID: 3, Name: AttributeAttackKnockback, Default: 0, Min: 0, Max: 5},
+	{ID: 4, Name: AttributeAttackSpeed, Default: 4, Min: 0, Max: 1024},
+	{ID: 5, Name: AttributeBlockBreakSpeed, Default: 1, Min: 0, Max: 1024},
+	{ID: 6, Name: AttributeBlockInteractionRange, Default: 4.5, Min: 0, Max: 64},
+	{ID: 9, Name: AttributeEntityInteractionRange, Default: 3, Min: 0, Max: 64},
+	{ID: 10, Name: AttributeFallDamageMultiplier, Default: 1, Min: 0, Max: 100},
+	{ID: 13, Name: AttributeGravity, Default: 0.08, Min: -1, Max: 1},
+	{ID: 14, Name: AttributeJumpStrength, Default: 0.42, Min: 0, Max: 32},
+	{ID: 15, Name: AttributeKnockbackResistance, Default: 0, Min: 0, Max: 1},
+	{ID: 17, Name: AttributeMaxAbsorption, Default: 0, Min: 0, Max: 2048},
+	{ID: 18, Name: AttributeMaxHealth, Default: 20, Min: 1, Max: 1024},
+	{ID: 19, Name: AttributeMiningEfficiency, Default: 0, Min: 0, Max: 1024},
+	{ID: 20, Name: AttributeMovementEfficiency, Default: 0, Min: 0, Max: 1},
+	{ID: 21, Name: AttributeMovementSpeed, Default: 0.7, Min: 0, Max: 1024},
+	{ID: 22, Name: AttributeOxygenBonus, Default: 0, Min: 0, Max: 1024},
+	{ID: 23, Name: AttributeSafeFallDistance, Default: 3, Min: -1024, Max: 1024},
+	{ID: 24, Name: AttributeScale, Default: 1, Min: 0.0625, Max: 16},
+	{ID: 25, Name: AttributeSneakingSpeed, Default: 0.3, Min: 0, Max: 1},
+	{ID: 27, Name: AttributeStepHeight, Default: 0.6, Min: 0, Max: 10},
+	{ID: 28, Name: AttributeSubmergedMiningSpeed, Default: 0.2, Min: 0, Max: 20},
+	{ID: 31, Name: AttributeWaterMovementEfficiency, Default: 0, Min: 0, Max: 1},
+}
+
+// PlayerAttributeBase holds the player base values that differ from the attribute defaults
+var PlayerAttributeBase = map[string]float64{
+	AttributeAttackDamage:  1,
+	AttributeMovementSpeed: 0.1,
+}
+
+// AttributeName normalizes an attribute name: adds the "minecraft:" namespace and
+// strips the "generic.", "player." and "zombie." prefixes used before 1.21.2
+func AttributeName(name string) string {
+	namespace, path, ok := strings.Cut(name, ":")
+	if !ok {
+		namespace, path = "minecraft", name
+	}
+	for _, prefix := range []string{"generic.", "player.", "zombie."} {
+		path = strings.TrimPrefix(path, prefix)
+	}
+	return namespace + ":" + path
+}
+
+// Attributes holds an entity's attribute base values and modifiers, and caches
+// their computed values
+type Attributes struct {
+	mu        sync.RWMutex
+	info      map[string]AttributeInfo
+	instances map[string]*attributeInstance
+}
+
+// attributeInstance is the state of one attribute
+type attributeInstance struct {
+	base      float64
+	modifiers map[string]inventory.AttributeModifier // By modifier ID
+	value     float64
+	valid     bool
+}
+
+// NewAttributes creates an attribute container; attributes without a base value use
+// the defaults of infos
+func NewAttributes(infos []AttributeInfo) *Attributes {
+	a := &Attributes{
+		info:      make(map[string]AttributeInfo, len(infos)),
+		instances: make(map[string]*attributeInstance),
+	}
+	for _, info := range infos {
+		a.info[AttributeName(info.Name)] = info
+	}
+	return a
+}
+
+// NewPlayerAttributes creates an attribute container with the player base values
+func NewPlayerAttributes(infos []AttributeInfo) *Attributes {
+	a := NewAttributes(infos)
+	for name, base := range PlayerAttributeBase {
+		a.SetBase(name, base)
+	}
+	return a
+}
+
+// Info returns the type info of an attribute
+func (a *Attributes) Info(name string) (AttributeInfo, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	info, ok := a.info[AttributeName(name)]
+	return info, ok
+}
+
+// Has checks if an attribute has a base value or modifiers set
+func (a *Attributes) Has(name string) bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	_, ok := a.instances[AttributeName(name)]
+	return ok
+}
+
+// Names returns the sorted names of the attributes that are set
+func (a *Attributes) Names() []string {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	names := make([]string, 0, len(a.instances))
+	for name := range a.instances {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Base returns the base value of an attribute (its default if not set)
+func (a *Attributes) Base(name string) float64 {
+	name = AttributeName(name)
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	if inst, ok := a.instances[name]; ok {
+		return inst.base
+	}
+	return a.info[name].Default
+}
+
+// SetBase sets the base value of an attribute
+func (a *Attributes) SetBase(name string, base float64) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	inst := a.instance(AttributeName(name))
+	inst.base = base
+	inst.valid = false
+}
+
+// Modifier returns a modifier of an attribute by ID
+func (a *Attributes) Modifier(name, id string) (inventory.AttributeModifier, bool) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	inst, ok := a.instances[AttributeName(name)]
+	if !ok {
+		return inventory.AttributeModifier{}, false
+	}
+	m, ok := inst.modifiers[id]
+	return m, ok
+}
+
+// Modifiers returns the modifiers of an attribute sorted by ID
+func (a *Attributes) Modifiers(name string) []inventory.AttributeModifier {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	inst, ok := a.instances[AttributeName(name)]
+	if !ok {
+		return nil
+	}
+	modifiers := make([]inventory.AttributeModifier, 0, len(inst.modifiers))
+	for _, m := range inst.modifiers {
+		modifiers = append(modifiers, m)
+	}
+	sort.Slice(modifiers, func(i, j int) bool { return modifiers[i].ID < modifiers[j].ID })
+	return modifiers
+}
+
+// AddModifier adds a modifier to an attribute, replacing any with the same ID
+func (a *Attributes) AddModifier(name string, m inventory.AttributeModifier) {
+	name = AttributeName(name)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	inst := a.instance(name)
+	m.Type = name
+	inst.modifiers[m.ID] = m
+	inst.valid = false
+}
+
+// RemoveModifier removes a modifier by ID, returning false if it was not present
+func (a *Attributes) RemoveModifier(name, id string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	inst, ok := a.instances[AttributeName(name)]
+	if !ok {
+		return false
+	}
+	if _, ok := inst.modifiers[id]; !ok {
+		return false
+	}
+	delete(inst.modifiers, id)
+	inst.valid = false
+	return true
+}
+
+// Update replaces the base value and all modifiers of an attribute (as the Update
+// Attributes packet does)
+func (a *Attributes) Update(name string, base float64, modifiers []inventory.AttributeModifier) {
+	name = AttributeName(name)
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	inst := a.instance(name)
+	inst.base = base
+	inst.modifiers = make(map[string]inventory.AttributeModifier, len(modifiers))
+	for _, m := range modifiers {
+		m.Type = name
+		inst.modifiers[m.ID] = m
+	}
+	inst.valid = false
+}
+
+// Value returns the computed value of an attribute: its base value with modifiers
+// applied in vanilla order and clamped to the attribute's range
+func (a *Attributes) Value(name string) float64 {
+	name = AttributeName(name)
+	a.mu.RLock()
+	inst, ok := a.instances[name]
+	if !ok {
+		value := a.info[name].Default
+		a.mu.RUnlock()
+		return value
+	}
+	if inst.valid {
+		value := inst.value
+		a.mu.RUnlock()
+		return value
+	}
+	a.mu.RUnlock()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !inst.valid {
+		modifiers := make([]inventory.AttributeModifier, 0, len(inst.modifiers))
+		for _, m := range inst.modifiers {
+			modifiers = append(modifiers, m)
+		}
+		min, max := a.bounds(name)
+		inst.value = EvaluateAttribute(inst.base, modifiers, min, max)
+		inst.valid = true
+	}
+	return inst.value
+}
+
+// limits returns the range of an attribute (unbounded if unknown)
+func (a *Attributes) limits(name string) (float64, float64) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.bounds(AttributeName(name))
+}
+
+// bounds returns the range of an attribute; the caller holds the lock
+func (a *Attributes) bounds(name string) (float64, float64) {
+	if info, ok := a.info[name]; ok {
+		return info.Min, info.Max
+	}
+	return math.Inf(-1), math.Inf(1)
+}
+
+// instance returns the state of an attribute, creating it with the default base value
+func (a *Attributes) instance(name string) *attributeInstance {
+	inst, ok := a.instances[name]
+	if !ok {
+		inst = &attributeInstance{
+			base:      a.info[name].Default,
+			modifiers: make(map[string]inventory.AttributeModifier),
+		}
+		a.instances[name] = inst
+	}
+	return inst
+}
+
+// EvaluateAttribute applies modifiers in vanilla order: add_value amounts are summed
+// into the base, add_multiplied_base amounts add multiples of that base, then each
+// add_multiplied_total multiplies the total. The result is clamped to [min, max].
+func EvaluateAttribute(base float64, modifiers []inventory.AttributeModifier, min, max float64) float64 {
+	for _, m := range modifiers {
+		if m.Operation == inventory.AttributeAddValue {
+			base += m.Amount
+		}
+	}
+	value := base
+	for _, m := range modifiers {
+		if m.Operation == inventory.AttributeAddMultipliedBase {
+			value += base * m.Amount
+		}
+	}
+	for _, m := range modifiers {
+		if m.Operation == inventory.AttributeAddMultipliedTotal {
+			value *= 1 + m.Amount
+		}
+	}
+	return math.Max(min, math.Min(value, max))
+}
+
+// AttributeValue returns the computed value of an entity attribute (the default
+// if the entity has no attribute container)
+func (e *Entity) AttributeValue(name string) float64 {
+	if e.Attributes == nil {
+		for _, info := range DefaultAttributes {
+			if info.Name == AttributeName(name) {
+				return info.Default
+			}
+		}
+		return 0
+	}
+	return e.Attributes.Value(name)
+}
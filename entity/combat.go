@@ -0,0 +1,161 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/konjacbot/prismarine-go/inventory"
+)
+
+// effectModifiers are the attribute modifiers of status effects at level 1
+// (vanilla multiplies the amount by the level)
+var effectModifiers = map[string]inventory.AttributeModifier{
+	EffectSpeed:         {Type: AttributeMovementSpeed, ID: "minecraft:effect.speed", Amount: 0.2, Operation: inventory.AttributeAddMultipliedTotal},
+	EffectSlowness:      {Type: AttributeMovementSpeed, ID: "minecraft:effect.slowness", Amount: -0.15, Operation: inventory.AttributeAddMultipliedTotal},
+	EffectHaste:         {Type: AttributeAttackSpeed, ID: "minecraft:effect.haste", Amount: 0.1, Operation: inventory.AttributeAddMultipliedTotal},
+	EffectMiningFatigue: {Type: AttributeAttackSpeed, ID: "minecraft:effect.mining_fatigue", Amount: -0.1, Operation: inventory.AttributeAddMultipliedTotal},
+	EffectStrength:      {Type: AttributeAttackDamage, ID: "minecraft:effect.strength", Amount: 3, Operation: inventory.AttributeAddValue},
+	EffectWeakness:      {Type: AttributeAttackDamage, ID: "minecraft:effect.weakness", Amount: -4, Operation: inventory.AttributeAddValue},
+	EffectHealthBoost:   {Type: AttributeMaxHealth, ID: "minecraft:effect.health_boost", Amount: 4, Operation: inventory.AttributeAddValue},
+}
+
+// sprintModifier is added to movement speed while sprinting
+var sprintModifier = inventory.AttributeModifier{
+	Type: AttributeMovementSpeed, ID: "minecraft:sprinting", Amount: 0.3, Operation: inventory.AttributeAddMultipliedTotal,
+}
+
+// Modifiers collects the attribute modifiers of an attribute from the equipment (in
+// the slots each modifier applies to), active effects (nil for none) and sprinting
+func (e *Entity) Modifiers(attribute string, effects EffectLevels) []inventory.AttributeModifier {
+	var modifiers []inventory.AttributeModifier
+	for slot, name := range equipmentSlotNames {
+		stack := e.Item(slot)
+		if stack == nil {
+			continue
+		}
+		for _, m := range stack.AttributeModifiers() {
+			if m.Type == attribute && slotInGroup(m.Slot, name) {
+				modifiers = append(modifiers, m)
+			}
+		}
+	}
+	if effects != nil {
+		for effect, m := range effectModifiers {
+			if level := effects.EffectLevel(effect); level > 0 && m.Type == attribute {
+				m.Amount *= float64(level)
+				modifiers = append(modifiers, m)
+			}
+		}
+	}
+	if attribute == AttributeMovementSpeed && e.hasFlag(FlagSprinting) {
+		modifiers = append(modifiers, sprintModifier)
+	}
+	return modifiers
+}
+
+// hasFlag checks a bit of the entity flags metadata
+func (e *Entity) hasFlag(flag byte) bool {
+	flags, _ := e.Metadata[MetadataIndexFlags].(byte)
+	return flags&flag != 0
+}
+
+// Stats is the final value of the combat attributes
+type Stats struct {
+	Armor               float64 // Armor points
+	ArmorToughness      float64 // Armor toughness
+	MaxHealth           float64 // Maximum health
+	AttackDamage        float64 // Melee damage before enchantments and the attack cooldown
+	AttackSpeed         float64 // Full-strength attacks per second
+	MovementSpeed       float64 // Movement speed (0.1 for a walking player)
+	KnockbackResistance float64 // Chance to resist knockback (0-1)
+}
+
+// Stats computes the player's combat attributes from the attribute base values,
+// equipment and active effects (nil for none). Server-sent modifiers are left out
+// since they repeat the equipment and effect modifiers.
+func (p *Player) Stats(effects EffectLevels) Stats {
+	attrs := p.Attributes
+	if attrs == nil {
+		attrs = NewPlayerAttributes(DefaultAttributes)
+	}
+	attr := func(name string) float64 {
+		min, max := attrs.limits(name)
+		return EvaluateAttribute(attrs.Base(name), p.Modifiers(name, effects), min, max)
+	}
+	return Stats{
+		Armor:               attr(AttributeArmor),
+		ArmorToughness:      attr(AttributeArmorToughness),
+		MaxHealth:           attr(AttributeMaxHealth),
+		AttackDamage:        attr(AttributeAttackDamage),
+		AttackSpeed:         attr(AttributeAttackSpeed),
+		MovementSpeed:       attr(AttributeMovementSpeed),
+		KnockbackResistance: attr(AttributeKnockbackResistance),
+	}
+}
+
+// DamageSource describes which reductions apply to a hit
+type DamageSource struct {
+	Kind                    inventory.DamageKind // Protection enchantments that apply
+	BypassesArmor           bool                 // Armor and toughness are ignored
+	BypassesEffects         bool                 // Resistance and enchantment protection are ignored
+	BypassesInvulnerability bool                 // Hurts creative and spectator players
+}
+
+// Common damage sources (vanilla damage type tags)
+var (
+	SourceMelee      = DamageSource{Kind: inventory.DamageGeneric}
+	SourceProjectile = DamageSource{Kind: inventory.DamageProjectile}
+	SourceExplosion  = DamageSource{Kind: inventory.DamageExplosion}
+	SourceInFire     = DamageSource{Kind: inventory.DamageFire}
+	SourceOnFire     = DamageSource{Kind: inventory.DamageFire, BypassesArmor: true}
+	SourceLava       = DamageSource{Kind: inventory.DamageFire}
+	SourceFall       = DamageSource{Kind: inventory.DamageFall, BypassesArmor: true}
+	SourceMagic      = DamageSource{Kind: inventory.DamageGeneric, BypassesArmor: true}
+	SourceDrown      = DamageSource{Kind: inventory.DamageGeneric, BypassesArmor: true}
+	SourceStarve     = DamageSource{Kind: inventory.DamageBypass, BypassesArmor: true, BypassesEffects: true}
+	SourceVoid       = DamageSource{Kind: inventory.DamageBypass, BypassesArmor: true, BypassesEffects: true, BypassesInvulnerability: true}
+)
+
+// DamageResult is the outcome of a hit
+type DamageResult struct {
+	Damage   float64 // Damage left after armor, Resistance and enchantments
+	Absorbed float64 // Part taken by absorption hearts
+	Health   float64 // Health lost
+	Fatal    bool    // Health drops to zero (ignoring totems)
+}
+
+// DamageTaken computes a hit on the player after armor and toughness, Resistance,
+// enchantment protection and absorption, in vanilla order. Difficulty scaling
+// (ScaleDamageForDifficulty) must be applied to the damage beforehand.
+func (p *Player) DamageTaken(damage float64, source DamageSource, effects EffectLevels) DamageResult {
+	if (p.GameMode == 1 || p.GameMode == 3) && !source.BypassesInvulnerability {
+		return DamageResult{}
+	}
+	if !source.BypassesArmor {
+		stats := p.Stats(effects)
+		damage = DamageAfterArmor(damage, stats.Armor, stats.ArmorToughness)
+	}
+	if !source.BypassesEffects && effects != nil {
+		if level := effects.EffectLevel(EffectResistance); level > 0 {
+			damage = math.Max(damage*float64(25-level*5)/25, 0)
+		}
+	}
+	if damage > 0 && !source.BypassesEffects {
+		damage = DamageAfterProtection(damage, inventory.ProtectionEPF(p.Armor(), source.Kind))
+	}
+
+	result := DamageResult{Damage: damage}
+	result.Absorbed = math.Min(damage, float64(p.Absorption))
+	result.Health = damage - result.Absorbed
+	result.Fatal = result.Health >= float64(p.Health)
+	return result
+}
+
+// HitsToDie returns the number of identical hits that kill the player, or -1 if the
+// hits do no damage
+func (p *Player) HitsToDie(damage float64, source DamageSource, effects EffectLevels) int {
+	per := p.DamageTaken(damage, source, effects).Damage
+	if per <= 0 {
+		return -1
+	}
+	return int(math.Ceil(float64(p.Health+p.Absorption) / per))
+}
@@ -0,0 +1,34 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// ScaleDamageForDifficulty applies the difficulty scaling used for damage to players
+// from mobs and explosions
+func ScaleDamageForDifficulty(damage float64, difficulty world.Difficulty) float64 {
+	switch difficulty {
+	case world.DifficultyPeaceful:
+		return 0
+	case world.DifficultyEasy:
+		return math.Min(damage/2+1, damage)
+	case world.DifficultyHard:
+		return damage * 3 / 2
+	}
+	return damage
+}
+
+// DamageAfterArmor applies armor and armor toughness reduction
+func DamageAfterArmor(damage, armor, toughness float64) float64 {
+	f := 2 + toughness/4
+	effective := math.Max(armor*0.2, math.Min(armor-damage/f, 20))
+	return damage * (1 - effective/25)
+}
+
+// DamageAfterProtection applies enchantment protection (EPF, capped at 20)
+func DamageAfterProtection(damage float64, epf int) float64 {
+	f := math.Max(0, math.Min(float64(epf), 20))
+	return damage * (1 - f/25)
+}
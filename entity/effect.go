@@ -0,0 +1,195 @@
+package entity
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/inventory"
+)
+
+// Status effect names that change what a bot can do
+const (
+	EffectSpeed          = "minecraft:speed"
+	EffectSlowness       = "minecraft:slowness"
+	EffectHaste          = "minecraft:haste"
+	EffectMiningFatigue  = "minecraft:mining_fatigue"
+	EffectStrength       = "minecraft:strength"
+	EffectJumpBoost      = "minecraft:jump_boost"
+	EffectRegeneration   = "minecraft:regeneration"
+	EffectResistance     = "minecraft:resistance"
+	EffectFireResistance = "minecraft:fire_resistance"
+	EffectWaterBreathing = "minecraft:water_breathing"
+	EffectInvisibility   = "minecraft:invisibility"
+	EffectBlindness      = "minecraft:blindness"
+	EffectNightVision    = "minecraft:night_vision"
+	EffectHunger         = "minecraft:hunger"
+	EffectSaturation     = "minecraft:saturation"
+	EffectWeakness       = "minecraft:weakness"
+	EffectPoison         = "minecraft:poison"
+	EffectWither         = "minecraft:wither"
+	EffectHealthBoost    = "minecraft:health_boost"
+	EffectAbsorption     = "minecraft:absorption"
+	EffectLevitation     = "minecraft:levitation"
+	EffectSlowFalling    = "minecraft:slow_falling"
+	EffectConduitPower   = "minecraft:conduit_power"
+	EffectDolphinsGrace  = "minecraft:dolphins_grace"
+	EffectDarkness       = "minecraft:darkness"
+)
+
+// EffectCategory is whether an effect helps or hurts
+type EffectCategory string
+
+const (
+	EffectBeneficial EffectCategory = "beneficial"
+	EffectHarmful    EffectCategory = "harmful"
+	EffectNeutral    EffectCategory = "neutral"
+)
+
+// EffectInfo describes a status effect type
+type EffectInfo struct {
+	ID       int            // Registry ID (sent in Entity Effect)
+	Name     string         // Effect name (e.g. "minecraft:speed")
+	Category EffectCategory // Beneficial, harmful or neutral
+	Color    int            // Particle color (RGB)
+}
+
+// Entity Effect packet flags
+const (
+	EffectFlagAmbient   byte = 0x01 // From a beacon or conduit
+	EffectFlagParticles byte = 0x02 // Particles are visible
+	EffectFlagIcon      byte = 0x04 // Icon is shown in the HUD
+)
+
+// NewEffectInstance builds an active effect from Entity Effect packet fields
+// (duration -1 for infinite)
+func NewEffectInstance(name string, amplifier, duration int, flags byte) inventory.EffectInstance {
+	return inventory.EffectInstance{
+		ID:            name,
+		Amplifier:     amplifier,
+		Duration:      duration,
+		Ambient:       flags&EffectFlagAmbient != 0,
+		ShowParticles: flags&EffectFlagParticles != 0,
+		ShowIcon:      flags&EffectFlagIcon != 0,
+	}
+}
+
+// EffectLevels reports active status effects by name (e.g. "minecraft:speed")
+type EffectLevels interface {
+	EffectLevel(name string) int // Effect level (amplifier + 1, 0 if inactive)
+}
+
+// Effects holds the active status effects of an entity by name. A nil *Effects
+// (entities not built by NewPlayer) has no effects; every method but Add accepts it.
+type Effects struct {
+	mu     sync.RWMutex
+	active map[string]inventory.EffectInstance
+}
+
+// NewEffects creates an empty effect list
+func NewEffects() *Effects {
+	return &Effects{active: make(map[string]inventory.EffectInstance)}
+}
+
+// Add adds or replaces an effect (as the Entity Effect packet does)
+func (e *Effects) Add(effect inventory.EffectInstance) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.active[effect.ID] = effect
+}
+
+// Remove removes an effect, returning false if it was not active
+func (e *Effects) Remove(name string) bool {
+	if e == nil {
+		return false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if _, ok := e.active[name]; !ok {
+		return false
+	}
+	delete(e.active, name)
+	return true
+}
+
+// Clear removes all effects
+func (e *Effects) Clear() {
+	if e == nil {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	clear(e.active)
+}
+
+// Get returns an active effect
+func (e *Effects) Get(name string) (inventory.EffectInstance, bool) {
+	if e == nil {
+		return inventory.EffectInstance{}, false
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	effect, ok := e.active[name]
+	return effect, ok
+}
+
+// Has checks if an effect is active
+func (e *Effects) Has(name string) bool {
+	_, ok := e.Get(name)
+	return ok
+}
+
+// EffectLevel returns the level of an effect (amplifier + 1, 0 if inactive)
+func (e *Effects) EffectLevel(name string) int {
+	effect, ok := e.Get(name)
+	if !ok {
+		return 0
+	}
+	return effect.Amplifier + 1
+}
+
+// All returns the active effects sorted by name
+func (e *Effects) All() []inventory.EffectInstance {
+	if e == nil {
+		return nil
+	}
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	all := make([]inventory.EffectInstance, 0, len(e.active))
+	for _, effect := range e.active {
+		all = append(all, effect)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].ID < all[j].ID })
+	return all
+}
+
+// Tick counts down effect durations by one tick and removes the effects that run
+// out, returning their sorted names. Infinite effects (duration -1) are kept.
+func (e *Effects) Tick() []string {
+	if e == nil {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	var expired []string
+	for name, effect := range e.active {
+		if effect.Duration < 0 {
+			continue
+		}
+		effect.Duration--
+		if effect.Duration <= 0 {
+			delete(e.active, name)
+			expired = append(expired, name)
+			continue
+		}
+		e.active[name] = effect
+	}
+	sort.Strings(expired)
+	return expired
+}
+
+// ApplyDig sets the Haste (or Conduit Power, whichever is higher) and Mining Fatigue
+// levels of mining conditions
+func (e *Effects) ApplyDig(cond *inventory.DigConditions) {
+	cond.Haste = max(e.EffectLevel(EffectHaste), e.EffectLevel(EffectConduitPower))
+	cond.MiningFatigue = e.EffectLevel(EffectMiningFatigue)
+}
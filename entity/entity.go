@@ -1,17 +1,23 @@
 package entity
 
-import "github.com/konjacbot/prismarine-go/world"
+import (
+	"github.com/konjacbot/prismarine-go/inventory"
+	"github.com/konjacbot/prismarine-go/uuid"
+	"github.com/konjacbot/prismarine-go/world"
+)
 
 // Entity represents a basic entity in the Minecraft world
 type Entity struct {
-	EID       int32                 // Entity ID
-	UUID      [16]byte              // Entity UUID
-	Type      int32                 // Entity type ID
-	Position  world.Vec3d           // Current position
-	Velocity  world.Vec3d           // Movement velocity
-	Rotation  world.Vec2            // Yaw, Pitch
-	Metadata  map[uint8]interface{} // Entity metadata
-	Equipment map[int8]interface{}  // Equipment slots (will be ItemStack from inventory package)
+	EID        int32                         // Entity ID
+	UUID       uuid.UUID                     // Entity UUID
+	Type       int32                         // Entity type ID
+	Position   world.Vec3d                   // Current position
+	Velocity   world.Vec3d                   // Movement velocity
+	Rotation   world.Vec2                    // Yaw, Pitch
+	Metadata   map[uint8]interface{}         // Entity metadata
+	Equipment  map[int8]*inventory.ItemStack // Equipment slots (EquipmentMainHand, ...)
+	Attributes *Attributes                   // Attribute base values and modifiers (nil if unknown)
+	Effects    *Effects                      // Active status effects
 }
 
 // ID returns the entity ID
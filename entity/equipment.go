@@ -0,0 +1,93 @@
+package entity
+
+import "github.com/konjacbot/prismarine-go/inventory"
+
+// Equipment slot indices (Set Equipment packet numbering)
+const (
+	EquipmentMainHand   int8 = 0
+	EquipmentOffHand    int8 = 1
+	EquipmentBoots      int8 = 2
+	EquipmentLeggings   int8 = 3
+	EquipmentChestplate int8 = 4
+	EquipmentHelmet     int8 = 5
+	EquipmentBody       int8 = 6 // Horse and wolf armor
+)
+
+// equipmentSlotNames maps equipment slots to the names used by item components
+var equipmentSlotNames = map[int8]string{
+	EquipmentMainHand:   inventory.EquipMainhand,
+	EquipmentOffHand:    inventory.EquipOffhand,
+	EquipmentBoots:      inventory.EquipFeet,
+	EquipmentLeggings:   inventory.EquipLegs,
+	EquipmentChestplate: inventory.EquipChest,
+	EquipmentHelmet:     inventory.EquipHead,
+	EquipmentBody:       inventory.EquipBody,
+}
+
+// armorSlots lists the worn armor slots from helmet to boots
+var armorSlots = []int8{EquipmentHelmet, EquipmentChestplate, EquipmentLeggings, EquipmentBoots}
+
+// Item returns the stack in an equipment slot (nil if empty)
+func (e *Entity) Item(slot int8) *inventory.ItemStack {
+	stack := e.Equipment[slot]
+	if stack == nil || stack.IsEmpty() {
+		return nil
+	}
+	return stack
+}
+
+// SetItem sets the stack in an equipment slot (nil clears it)
+func (e *Entity) SetItem(slot int8, stack *inventory.ItemStack) {
+	if e.Equipment == nil {
+		e.Equipment = make(map[int8]*inventory.ItemStack)
+	}
+	if stack == nil || stack.IsEmpty() {
+		delete(e.Equipment, slot)
+		return
+	}
+	e.Equipment[slot] = stack
+}
+
+// Armor returns copies of the worn armor stacks (empty stacks for bare slots)
+func (e *Entity) Armor() []inventory.ItemStack {
+	armor := make([]inventory.ItemStack, 0, len(armorSlots))
+	for _, slot := range armorSlots {
+		if stack := e.Item(slot); stack != nil {
+			armor = append(armor, *stack)
+		} else {
+			armor = append(armor, inventory.ItemStack{})
+		}
+	}
+	return armor
+}
+
+// EquipFromInventory points the equipment slots at the held item, offhand and armor of
+// a player inventory (the server does not send Set Equipment for our own player)
+func (p *Player) EquipFromInventory(inv *inventory.Inventory) {
+	slots := map[int8]int{
+		EquipmentMainHand:   inventory.SlotHotbarStart + inv.HeldSlot,
+		EquipmentOffHand:    inventory.SlotOffhand,
+		EquipmentHelmet:     inventory.SlotArmorHead,
+		EquipmentChestplate: inventory.SlotArmorChest,
+		EquipmentLeggings:   inventory.SlotArmorLegs,
+		EquipmentBoots:      inventory.SlotArmorFeet,
+	}
+	for equipment, slot := range slots {
+		stack, _ := inv.GetSlot(slot)
+		p.SetItem(equipment, stack)
+	}
+}
+
+// slotInGroup checks if an equipment slot name belongs to a modifier slot group
+func slotInGroup(group, slot string) bool {
+	switch group {
+	case "", "any":
+		return true
+	case "hand":
+		return slot == inventory.EquipMainhand || slot == inventory.EquipOffhand
+	case "armor":
+		return slot == inventory.EquipHead || slot == inventory.EquipChest ||
+			slot == inventory.EquipLegs || slot == inventory.EquipFeet
+	}
+	return group == slot
+}
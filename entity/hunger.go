@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"math"
+
+	"github.com/konjacbot/prismarine-go/inventory"
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// MaxExhaustion caps the accumulated food exhaustion
+const MaxExhaustion = 40
+
+// Exhaustion added by player actions (vanilla values)
+const (
+	ExhaustionSprint     = 0.1   // Per meter sprinted
+	ExhaustionSwim       = 0.01  // Per meter swum
+	ExhaustionJump       = 0.05  // Per jump
+	ExhaustionSprintJump = 0.2   // Per jump while sprinting
+	ExhaustionAttack     = 0.1   // Per attack
+	ExhaustionDamage     = 0.1   // Per hit taken (most damage types)
+	ExhaustionMine       = 0.005 // Per block broken
+	ExhaustionHungerTick = 0.005 // Per tick and level of the Hunger effect
+)
+
+// HungerTick is what a hunger update did to the player
+type HungerTick struct {
+	Healed  float32 // Health restored by natural regeneration
+	Starved float32 // Starvation damage taken (absorption first)
+}
+
+// AddExhaustion adds exhaustion from an action (capped at MaxExhaustion)
+func (p *Player) AddExhaustion(amount float32) {
+	p.Exhaustion = min(p.Exhaustion+amount, MaxExhaustion)
+}
+
+// CanEat checks if the player can start eating a food
+func (p *Player) CanEat(food inventory.Food) bool {
+	return p.GameMode == 1 || food.CanAlwaysEat || p.Food < inventory.MaxFoodLevel
+}
+
+// Eat restores food and saturation; saturation never exceeds the food level
+func (p *Player) Eat(food inventory.Food) {
+	p.Food = min(p.Food+food.Nutrition, inventory.MaxFoodLevel)
+	p.Saturation = min(p.Saturation+float32(food.Saturation), float32(p.Food))
+}
+
+// TickHunger runs one tick of the vanilla hunger model: exhaustion drains
+// saturation, then food; a full bar regenerates health quickly using saturation,
+// 18 or more food regenerates slowly, and an empty bar starves the player.
+// Hunger and Saturation effects are applied from the player's active effects.
+func (p *Player) TickHunger(difficulty world.Difficulty, naturalRegeneration bool) HungerTick {
+	var result HungerTick
+	if level := p.Effects.EffectLevel(EffectHunger); level > 0 {
+		p.AddExhaustion(ExhaustionHungerTick * float32(level))
+	}
+	if level := p.Effects.EffectLevel(EffectSaturation); level > 0 {
+		p.Eat(inventory.Food{Nutrition: level, Saturation: float64(level) * 2})
+	}
+
+	if p.Exhaustion > 4 {
+		p.Exhaustion -= 4
+		if p.Saturation > 0 {
+			p.Saturation = max(p.Saturation-1, 0)
+		} else if difficulty != world.DifficultyPeaceful {
+			p.Food = max(p.Food-1, 0)
+		}
+	}
+
+	hurt := float64(p.Health) < p.AttributeValue(AttributeMaxHealth)
+	switch {
+	case naturalRegeneration && p.Saturation > 0 && hurt && p.Food >= inventory.MaxFoodLevel:
+		p.foodTimer++
+		if p.foodTimer >= 10 {
+			amount := min(p.Saturation, 6)
+			result.Healed = p.heal(amount / 6)
+			p.AddExhaustion(amount)
+			p.foodTimer = 0
+		}
+	case naturalRegeneration && p.Food >= 18 && hurt:
+		p.foodTimer++
+		if p.foodTimer >= 80 {
+			result.Healed = p.heal(1)
+			p.AddExhaustion(6)
+			p.foodTimer = 0
+		}
+	case p.Food <= 0:
+		p.foodTimer++
+		if p.foodTimer >= 80 {
+			if p.Health > 10 || difficulty == world.DifficultyHard ||
+				(p.Health > 1 && difficulty == world.DifficultyNormal) {
+				hit := p.DamageTaken(1, SourceStarve, p.Effects)
+				p.Absorption -= float32(hit.Absorbed)
+				p.Health = max(p.Health-float32(hit.Health), 0)
+				result.Starved = float32(hit.Damage)
+			}
+			p.foodTimer = 0
+		}
+	default:
+		p.foodTimer = 0
+	}
+	return result
+}
+
+// heal adds health up to the maximum and returns the amount restored
+func (p *Player) heal(amount float32) float32 {
+	before := p.Health
+	p.Health = float32(math.Min(float64(p.Health+amount), p.AttributeValue(AttributeMaxHealth)))
+	return p.Health - before
+}
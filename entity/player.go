@@ -1,13 +1,22 @@
 package entity
 
+import (
+	"github.com/konjacbot/prismarine-go/inventory"
+	"github.com/konjacbot/prismarine-go/uuid"
+)
+
 // Player represents a player entity with additional properties
 type Player struct {
 	Entity             // Embed base entity
 	Name       string  // Player name
 	GameMode   int     // Game mode (0=survival, 1=creative, 2=adventure, 3=spectator)
 	Health     float32 // Player health
+	Absorption float32 // Absorption hearts (extra health lost first)
 	Food       int     // Food level
 	Saturation float32 // Food saturation
+	Exhaustion float32 // Food exhaustion (every 4 drains saturation or food)
+
+	foodTimer int // Ticks towards the next regeneration or starvation
 }
 
 // IsPlayer returns true if this entity is a player
@@ -16,14 +25,16 @@ func (e *Entity) IsPlayer() bool {
 }
 
 // NewPlayer creates a new player entity
-func NewPlayer(eid int32, uuid [16]byte, name string) *Player {
+func NewPlayer(eid int32, id uuid.UUID, name string) *Player {
 	return &Player{
 		Entity: Entity{
-			EID:       eid,
-			UUID:      uuid,
-			Type:      TypePlayer,
-			Metadata:  make(map[uint8]interface{}),
-			Equipment: make(map[int8]interface{}),
+			EID:        eid,
+			UUID:       id,
+			Type:       TypePlayer,
+			Metadata:   make(map[uint8]interface{}),
+			Equipment:  make(map[int8]*inventory.ItemStack),
+			Attributes: NewPlayerAttributes(DefaultAttributes),
+			Effects:    NewEffects(),
 		},
 		Name:     name,
 		GameMode: 0,
@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/chat"
+	"github.com/konjacbot/prismarine-go/uuid"
+)
+
+// PlayerListAction selects the fields carried by a player list update
+type PlayerListAction uint8
+
+const (
+	ActionAddPlayer         PlayerListAction = 0x01 // Profile (adds the entry)
+	ActionInitializeChat    PlayerListAction = 0x02 // Chat session (not tracked)
+	ActionUpdateGameMode    PlayerListAction = 0x04 // GameMode
+	ActionUpdateListed      PlayerListAction = 0x08 // Listed
+	ActionUpdateLatency     PlayerListAction = 0x10 // Latency
+	ActionUpdateDisplayName PlayerListAction = 0x20 // DisplayName
+	ActionUpdateListOrder   PlayerListAction = 0x40 // ListOrder (1.21.2+)
+	ActionUpdateHat         PlayerListAction = 0x80 // ShowHat (1.21.4+)
+)
+
+// PlayerInfo is an entry of the player list (tab list)
+type PlayerInfo struct {
+	Profile     GameProfile   // Account identity
+	GameMode    int           // Game mode (0=survival, 1=creative, 2=adventure, 3=spectator)
+	Latency     int           // Ping in milliseconds
+	Listed      bool          // Shown in the tab list
+	DisplayName *chat.Message // Tab list name (nil shows the profile name)
+	ListOrder   int           // Sort priority, higher first
+	ShowHat     bool          // Skin hat layer is shown
+}
+
+// DisplayText returns the tab list name as plain text, translated with t (nil for none)
+func (p *PlayerInfo) DisplayText(t *chat.Translator) string {
+	if p.DisplayName != nil {
+		return p.DisplayName.ToPlainText(t)
+	}
+	return p.Profile.Name
+}
+
+// PlayerList tracks the players online, keyed by UUID
+type PlayerList struct {
+	entries map[uuid.UUID]*PlayerInfo
+	mu      sync.RWMutex
+}
+
+// NewPlayerList creates an empty player list
+func NewPlayerList() *PlayerList {
+	return &PlayerList{
+		entries: make(map[uuid.UUID]*PlayerInfo),
+	}
+}
+
+// Update applies a player list update. Entries are matched by profile UUID; with
+// ActionAddPlayer they are created (or replaced), otherwise unknown UUIDs are
+// ignored. Only the fields selected by actions are copied.
+func (l *PlayerList) Update(actions PlayerListAction, entries []PlayerInfo) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, update := range entries {
+		id := update.Profile.UUID
+		if actions&ActionAddPlayer != 0 {
+			l.entries[id] = &PlayerInfo{Profile: update.Profile}
+		}
+		entry, ok := l.entries[id]
+		if !ok {
+			continue
+		}
+		if actions&ActionUpdateGameMode != 0 {
+			entry.GameMode = update.GameMode
+		}
+		if actions&ActionUpdateListed != 0 {
+			entry.Listed = update.Listed
+		}
+		if actions&ActionUpdateLatency != 0 {
+			entry.Latency = update.Latency
+		}
+		if actions&ActionUpdateDisplayName != 0 {
+			entry.DisplayName = update.DisplayName
+		}
+		if actions&ActionUpdateListOrder != 0 {
+			entry.ListOrder = update.ListOrder
+		}
+		if actions&ActionUpdateHat != 0 {
+			entry.ShowHat = update.ShowHat
+		}
+	}
+}
+
+// Remove removes players by UUID
+func (l *PlayerList) Remove(ids ...uuid.UUID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, id := range ids {
+		delete(l.entries, id)
+	}
+}
+
+// Clear removes all players
+func (l *PlayerList) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	clear(l.entries)
+}
+
+// Len returns the number of players
+func (l *PlayerList) Len() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.entries)
+}
+
+// Get returns a copy of a player's entry
+func (l *PlayerList) Get(id uuid.UUID) (PlayerInfo, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	entry, ok := l.entries[id]
+	if !ok {
+		return PlayerInfo{}, false
+	}
+	return *entry, true
+}
+
+// ByName finds a player by profile name (case-insensitive)
+func (l *PlayerList) ByName(name string) (PlayerInfo, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, entry := range l.entries {
+		if strings.EqualFold(entry.Profile.Name, name) {
+			return *entry, true
+		}
+	}
+	return PlayerInfo{}, false
+}
+
+// All returns copies of all entries sorted by profile name
+func (l *PlayerList) All() []PlayerInfo {
+	l.mu.RLock()
+	all := make([]PlayerInfo, 0, len(l.entries))
+	for _, entry := range l.entries {
+		all = append(all, *entry)
+	}
+	l.mu.RUnlock()
+	sort.Slice(all, func(i, j int) bool {
+		return strings.ToLower(all[i].Profile.Name) < strings.ToLower(all[j].Profile.Name)
+	})
+	return all
+}
+
+// Listed returns the entries shown in the tab list, in display order: higher list
+// order first, spectators last, then by profile name
+func (l *PlayerList) Listed() []PlayerInfo {
+	var listed []PlayerInfo
+	for _, entry := range l.All() {
+		if entry.Listed {
+			listed = append(listed, entry)
+		}
+	}
+	sort.SliceStable(listed, func(i, j int) bool {
+		if listed[i].ListOrder != listed[j].ListOrder {
+			return listed[i].ListOrder > listed[j].ListOrder
+		}
+		return listed[i].GameMode != 3 && listed[j].GameMode == 3
+	})
+	return listed
+}
+
+// EntryFor returns the player list entry of a player entity
+func (l *PlayerList) EntryFor(p *Player) (PlayerInfo, bool) {
+	return l.Get(p.UUID)
+}
+
+// Player looks up the tracked player entity of a list entry; it returns false if
+// the UUID is not in the list or the player has not been spawned with AddPlayer
+func (l *PlayerList) Player(tracker *Tracker, id uuid.UUID) (*Player, bool) {
+	if _, ok := l.Get(id); !ok {
+		return nil, false
+	}
+	return tracker.GetPlayerByUUID(id)
+}
+
+// NewPlayer creates the player entity for a spawned player with the name and game
+// mode from its list entry; it returns false if the UUID is not in the list
+func (l *PlayerList) NewPlayer(eid int32, id uuid.UUID) (*Player, bool) {
+	entry, ok := l.Get(id)
+	if !ok {
+		return nil, false
+	}
+	p := NewPlayer(eid, id, entry.Profile.Name)
+	p.GameMode = entry.GameMode
+	return p, true
+}
+
+// Sync copies the name and game mode of a player's list entry to the entity,
+// returning false if the player is not in the list
+func (l *PlayerList) Sync(p *Player) bool {
+	entry, ok := l.EntryFor(p)
+	if !ok {
+		return false
+	}
+	p.Name = entry.Profile.Name
+	p.GameMode = entry.GameMode
+	return true
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/konjacbot/prismarine-go/uuid"
+)
+
+var ErrNoTextures = errors.New("profile has no textures property")
+
+// ProfileProperty is a signed property of a game profile (e.g. "textures")
+type ProfileProperty struct {
+	Name      string // Property name
+	Value     string // Property value (base64 JSON for textures)
+	Signature string // Mojang signature (empty if unsigned)
+}
+
+// GameProfile is a player's account identity
+type GameProfile struct {
+	UUID       uuid.UUID         // Account UUID
+	Name       string            // Account name
+	Properties []ProfileProperty // Profile properties
+}
+
+// Property returns a profile property by name
+func (g *GameProfile) Property(name string) (ProfileProperty, bool) {
+	for _, p := range g.Properties {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return ProfileProperty{}, false
+}
+
+// ProfileTextures is the decoded "textures" property of a game profile
+type ProfileTextures struct {
+	Timestamp   int64     // Milliseconds since the epoch when the property was issued
+	ProfileID   uuid.UUID // UUID of the profile
+	ProfileName string    // Name of the profile
+	SkinURL     string    // Skin texture URL (empty for the default skin)
+	SkinModel   string    // "slim" for thin arms, empty for the classic model
+	CapeURL     string    // Cape texture URL (empty if none)
+}
+
+// Slim returns true if the skin uses the thin-armed model
+func (t *ProfileTextures) Slim() bool {
+	return t.SkinModel == "slim"
+}
+
+// texturesJSON is the JSON encoded in the textures property
+type texturesJSON struct {
+	Timestamp   int64     `json:"timestamp"`
+	ProfileID   uuid.UUID `json:"profileId"`
+	ProfileName string    `json:"profileName"`
+	Textures    struct {
+		Skin *struct {
+			URL      string `json:"url"`
+			Metadata struct {
+				Model string `json:"model"`
+			} `json:"metadata"`
+		} `json:"SKIN"`
+		Cape *struct {
+			URL string `json:"url"`
+		} `json:"CAPE"`
+	} `json:"textures"`
+}
+
+// Textures decodes the base64 "textures" property of the profile
+func (g *GameProfile) Textures() (*ProfileTextures, error) {
+	prop, ok := g.Property("textures")
+	if !ok {
+		return nil, ErrNoTextures
+	}
+	raw, err := base64.StdEncoding.DecodeString(prop.Value)
+	if err != nil {
+		return nil, err
+	}
+	var data texturesJSON
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return nil, err
+	}
+	textures := &ProfileTextures{
+		Timestamp:   data.Timestamp,
+		ProfileID:   data.ProfileID,
+		ProfileName: data.ProfileName,
+	}
+	if skin := data.Textures.Skin; skin != nil {
+		textures.SkinURL = skin.URL
+		textures.SkinModel = skin.Metadata.Model
+	}
+	if cape := data.Textures.Cape; cape != nil {
+		textures.CapeURL = cape.URL
+	}
+	return textures, nil
+}
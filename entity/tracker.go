@@ -0,0 +1,104 @@
+package entity
+
+import (
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/uuid"
+)
+
+// Tracker keeps track of the entities currently known to the client
+type Tracker struct {
+	entities map[int32]*Entity
+	players  map[int32]*Player // Player entities added with AddPlayer
+	mu       sync.RWMutex
+}
+
+// NewTracker creates a new empty entity tracker
+func NewTracker() *Tracker {
+	return &Tracker{
+		entities: make(map[int32]*Entity),
+		players:  make(map[int32]*Player),
+	}
+}
+
+// Add adds or replaces an entity
+func (t *Tracker) Add(e *Entity) {
+	t.mu.Lock()
+	t.entities[e.EID] = e
+	delete(t.players, e.EID)
+	t.mu.Unlock()
+}
+
+// AddPlayer adds or replaces a player entity so it can be looked up as a *Player
+func (t *Tracker) AddPlayer(p *Player) {
+	t.mu.Lock()
+	t.entities[p.EID] = &p.Entity
+	t.players[p.EID] = p
+	t.mu.Unlock()
+}
+
+// Remove removes an entity by ID
+func (t *Tracker) Remove(eid int32) {
+	t.mu.Lock()
+	delete(t.entities, eid)
+	delete(t.players, eid)
+	t.mu.Unlock()
+}
+
+// Get gets an entity by ID
+func (t *Tracker) Get(eid int32) (*Entity, bool) {
+	t.mu.RLock()
+	e, ok := t.entities[eid]
+	t.mu.RUnlock()
+	return e, ok
+}
+
+// GetByUUID gets an entity by UUID
+func (t *Tracker) GetByUUID(id uuid.UUID) (*Entity, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, e := range t.entities {
+		if e.UUID == id {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// GetPlayer gets a player entity by ID
+func (t *Tracker) GetPlayer(eid int32) (*Player, bool) {
+	t.mu.RLock()
+	p, ok := t.players[eid]
+	t.mu.RUnlock()
+	return p, ok
+}
+
+// GetPlayerByUUID gets a player entity by UUID
+func (t *Tracker) GetPlayerByUUID(id uuid.UUID) (*Player, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	for _, p := range t.players {
+		if p.UUID == id {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// All returns a snapshot of all tracked entities
+func (t *Tracker) All() []*Entity {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	all := make([]*Entity, 0, len(t.entities))
+	for _, e := range t.entities {
+		all = append(all, e)
+	}
+	return all
+}
+
+// Count returns the number of tracked entities
+func (t *Tracker) Count() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return len(t.entities)
+}
@@ -0,0 +1,133 @@
+package hud
+
+import (
+	"github.com/konjacbot/prismarine-go/chat"
+	"github.com/konjacbot/prismarine-go/uuid"
+)
+
+// BossBarColor is the color of a boss bar
+type BossBarColor int
+
+const (
+	BossBarPink BossBarColor = iota
+	BossBarBlue
+	BossBarRed
+	BossBarGreen
+	BossBarYellow
+	BossBarPurple
+	BossBarWhite
+)
+
+// BossBarDivision is the notch overlay of a boss bar
+type BossBarDivision int
+
+const (
+	DivisionNone BossBarDivision = iota // Solid bar
+	Division6                           // 6 notches
+	Division10                          // 10 notches
+	Division12                          // 12 notches
+	Division20                          // 20 notches
+)
+
+// Boss bar flags
+const (
+	FlagDarkenSky byte = 0x01 // Darkens the sky (wither)
+	FlagPlayMusic byte = 0x02 // Plays boss music (ender dragon)
+	FlagFog       byte = 0x04 // Creates fog
+)
+
+// BossBar is a bar shown at the top of the screen
+type BossBar struct {
+	UUID     uuid.UUID       // Bar ID
+	Title    *chat.Message   // Text above the bar
+	Progress float32         // Fill from 0 to 1
+	Color    BossBarColor    // Bar color
+	Division BossBarDivision // Notch overlay
+	Flags    byte            // Flag* bits
+}
+
+// HasFlag checks a boss bar flag
+func (b *BossBar) HasFlag(flag byte) bool {
+	return b.Flags&flag != 0
+}
+
+// AddBossBar adds a boss bar, or replaces one with the same UUID in place
+func (h *HUD) AddBossBar(bar BossBar) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.bossBars[bar.UUID]; !ok {
+		h.order = append(h.order, bar.UUID)
+	}
+	h.bossBars[bar.UUID] = &bar
+}
+
+// RemoveBossBar removes a boss bar, returning false if it was not shown
+func (h *HUD) RemoveBossBar(id uuid.UUID) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.bossBars[id]; !ok {
+		return false
+	}
+	delete(h.bossBars, id)
+	for i, o := range h.order {
+		if o == id {
+			h.order = append(h.order[:i], h.order[i+1:]...)
+			break
+		}
+	}
+	return true
+}
+
+// update applies a change to a boss bar
+func (h *HUD) update(id uuid.UUID, change func(*BossBar)) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	bar, ok := h.bossBars[id]
+	if !ok {
+		return ErrUnknownBossBar
+	}
+	change(bar)
+	return nil
+}
+
+// SetBossBarProgress updates the fill of a boss bar
+func (h *HUD) SetBossBarProgress(id uuid.UUID, progress float32) error {
+	return h.update(id, func(b *BossBar) { b.Progress = progress })
+}
+
+// SetBossBarTitle updates the title of a boss bar
+func (h *HUD) SetBossBarTitle(id uuid.UUID, title *chat.Message) error {
+	return h.update(id, func(b *BossBar) { b.Title = title })
+}
+
+// SetBossBarStyle updates the color and division of a boss bar
+func (h *HUD) SetBossBarStyle(id uuid.UUID, color BossBarColor, division BossBarDivision) error {
+	return h.update(id, func(b *BossBar) { b.Color, b.Division = color, division })
+}
+
+// SetBossBarFlags updates the flags of a boss bar
+func (h *HUD) SetBossBarFlags(id uuid.UUID, flags byte) error {
+	return h.update(id, func(b *BossBar) { b.Flags = flags })
+}
+
+// BossBar returns a copy of a boss bar
+func (h *HUD) BossBar(id uuid.UUID) (BossBar, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	bar, ok := h.bossBars[id]
+	if !ok {
+		return BossBar{}, false
+	}
+	return *bar, true
+}
+
+// BossBars returns copies of the boss bars from top to bottom
+func (h *HUD) BossBars() []BossBar {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	bars := make([]BossBar, 0, len(h.order))
+	for _, id := range h.order {
+		bars = append(bars, *h.bossBars[id])
+	}
+	return bars
+}
@@ -0,0 +1,79 @@
+// Package hud models the on-screen state servers send: boss bars, titles and the action bar
+package hud
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/chat"
+	"github.com/konjacbot/prismarine-go/uuid"
+)
+
+var ErrUnknownBossBar = errors.New("unknown boss bar")
+
+// HUD holds the boss bars, title and action bar shown to the player. Titles and
+// the action bar expire on a tick clock advanced by Tick.
+type HUD struct {
+	bossBars map[uuid.UUID]*BossBar
+	order    []uuid.UUID // Boss bars in the order they were added (top to bottom)
+
+	title     *chat.Message
+	subtitle  *chat.Message
+	titleTime int // Ticks left on screen (0 if no title)
+	fadeIn    int
+	stay      int
+	fadeOut   int
+
+	actionBar     *chat.Message
+	actionBarTime int
+
+	tick int64
+	mu   sync.RWMutex
+}
+
+// New creates an empty HUD with the default title timings
+func New() *HUD {
+	return &HUD{
+		bossBars: make(map[uuid.UUID]*BossBar),
+		fadeIn:   DefaultFadeIn,
+		stay:     DefaultStay,
+		fadeOut:  DefaultFadeOut,
+	}
+}
+
+// Tick advances the clock by one tick, expiring titles and the action bar
+func (h *HUD) Tick() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tick++
+	if h.titleTime > 0 {
+		h.titleTime--
+		if h.titleTime == 0 {
+			h.title, h.subtitle = nil, nil
+		}
+	}
+	if h.actionBarTime > 0 {
+		h.actionBarTime--
+		if h.actionBarTime == 0 {
+			h.actionBar = nil
+		}
+	}
+}
+
+// Ticks returns the number of ticks counted so far
+func (h *HUD) Ticks() int64 {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.tick
+}
+
+// Reset clears everything (e.g. on respawn or server switch)
+func (h *HUD) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	clear(h.bossBars)
+	h.order = nil
+	h.title, h.subtitle, h.titleTime = nil, nil, 0
+	h.fadeIn, h.stay, h.fadeOut = DefaultFadeIn, DefaultStay, DefaultFadeOut
+	h.actionBar, h.actionBarTime = nil, 0
+}
@@ -0,0 +1,98 @@
+package hud
+
+import "github.com/konjacbot/prismarine-go/chat"
+
+// Default title timings in ticks
+const (
+	DefaultFadeIn  = 10
+	DefaultStay    = 70
+	DefaultFadeOut = 20
+)
+
+// ActionBarTicks is how long an action bar message stays on screen
+const ActionBarTicks = 60
+
+// TitleView is the title currently on screen
+type TitleView struct {
+	Title     *chat.Message // Large center text
+	Subtitle  *chat.Message // Smaller text below (nil if none)
+	Remaining int           // Ticks until it disappears
+	Opacity   float64       // 0-1 while fading in or out, 1 while staying
+}
+
+// SetTitle shows a title for the current fade-in, stay and fade-out time
+func (h *HUD) SetTitle(title *chat.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.title = title
+	h.titleTime = h.fadeIn + h.stay + h.fadeOut
+}
+
+// SetSubtitle sets the subtitle; it is shown with the current or next title
+func (h *HUD) SetSubtitle(subtitle *chat.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.subtitle = subtitle
+}
+
+// SetTitleTimes sets the title fade-in, stay and fade-out ticks, restarting a
+// title that is on screen
+func (h *HUD) SetTitleTimes(fadeIn, stay, fadeOut int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.fadeIn, h.stay, h.fadeOut = fadeIn, stay, fadeOut
+	if h.titleTime > 0 {
+		h.titleTime = fadeIn + stay + fadeOut
+	}
+}
+
+// ClearTitle hides the title and subtitle; reset also restores the default timings
+func (h *HUD) ClearTitle(reset bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.title, h.subtitle, h.titleTime = nil, nil, 0
+	if reset {
+		h.fadeIn, h.stay, h.fadeOut = DefaultFadeIn, DefaultStay, DefaultFadeOut
+	}
+}
+
+// Title returns the title on screen
+func (h *HUD) Title() (TitleView, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.title == nil || h.titleTime <= 0 {
+		return TitleView{}, false
+	}
+	view := TitleView{
+		Title:     h.title,
+		Subtitle:  h.subtitle,
+		Remaining: h.titleTime,
+		Opacity:   1,
+	}
+	elapsed := h.fadeIn + h.stay + h.fadeOut - h.titleTime
+	switch {
+	case elapsed < h.fadeIn:
+		view.Opacity = float64(elapsed) / float64(h.fadeIn)
+	case h.titleTime <= h.fadeOut:
+		view.Opacity = float64(h.titleTime) / float64(h.fadeOut)
+	}
+	return view, true
+}
+
+// SetActionBar shows a message above the hotbar for ActionBarTicks
+func (h *HUD) SetActionBar(message *chat.Message) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.actionBar = message
+	h.actionBarTime = ActionBarTicks
+}
+
+// ActionBar returns the action bar message on screen and its remaining ticks
+func (h *HUD) ActionBar() (*chat.Message, int, bool) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	if h.actionBar == nil || h.actionBarTime <= 0 {
+		return nil, 0, false
+	}
+	return h.actionBar, h.actionBarTime, true
+}
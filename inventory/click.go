@@ -0,0 +1,720 @@
+package inventory
+
+import (
+	"errors"
+)
+
+var ErrInvalidClick = errors.New("invalid click")
+
+// SlotOutside is the slot number used for clicks outside the window
+const SlotOutside = -999
+
+// ClickMode is the click type sent with a container click
+type ClickMode int
+
+const (
+	ClickPickup    ClickMode = iota // Left (button 0) or right (button 1) click
+	ClickQuickMove                  // Shift-click
+	ClickSwap                       // Number key (button 0-8) or offhand swap (button 40)
+	ClickClone                      // Creative middle-click
+	ClickThrow                      // Drop key: one item (button 0) or the whole stack (button 1)
+	ClickDrag                       // Drag (start, add slot, end)
+	ClickPickupAll                  // Double-click
+)
+
+// Drag buttons (ClickDrag)
+const (
+	DragStartLeft   = 0
+	DragAddLeft     = 1
+	DragEndLeft     = 2
+	DragStartRight  = 4
+	DragAddRight    = 5
+	DragEndRight    = 6
+	DragStartMiddle = 8
+	DragAddMiddle   = 9
+	DragEndMiddle   = 10
+)
+
+// SwapOffhand is the ClickSwap button that swaps a slot with the offhand
+const SwapOffhand = 40
+
+// Click describes a single container click
+type Click struct {
+	Slot   int       // Window slot, or SlotOutside
+	Button int       // Mode-specific button
+	Mode   ClickMode // Click type
+}
+
+// SlotChange records a slot whose contents changed
+type SlotChange struct {
+	Slot int       // Window slot number
+	Old  ItemStack // Contents before the click
+	New  ItemStack // Contents after the click
+}
+
+// ClickResult is the predicted outcome of a click
+type ClickResult struct {
+	Changes []SlotChange // Changed window slots, in slot order
+	Offhand *SlotChange  // Offhand change when a container window is open (not a window slot)
+	Cursor  ItemStack    // Cursor stack after the click
+	Dropped []ItemStack  // Stacks thrown out of the window
+}
+
+// Container combines an open window, the player inventory and the cursor stack.
+// Window slots are numbered by the window's Layout: the container's own slots
+// first, followed by the 27 main inventory slots and the 9 hotbar slots.
+// With a nil Window the player inventory window itself is used (slots 0-45).
+type Container struct {
+	Window   *Window                    // Open window (nil for the player inventory)
+	Player   *Inventory                 // Player inventory (player window numbering)
+	Layout   *Layout                    // Slot layout of the window
+	Rules    map[SlotRule]ItemPredicate // Overrides for DefaultRules (optional)
+	Cursor   ItemStack                  // Stack carried by the cursor
+	Creative bool                       // Player has infinite materials (clone and middle drag)
+	Recipes  *RecipeBook                // Refills the crafting result when the grid pattern changes (optional)
+
+	dragging  bool
+	dragType  int
+	dragSlots []int
+}
+
+// NewContainer creates a container for a window (nil for the player inventory)
+func NewContainer(window *Window, player *Inventory) *Container {
+	layout := PlayerLayout
+	if window != nil {
+		layout = LayoutFor(window.Type, window.Size)
+	}
+	return &Container{Window: window, Player: player, Layout: layout}
+}
+
+// SlotCount returns the number of slots in the window numbering
+func (c *Container) SlotCount() int {
+	return c.Layout.SlotCount()
+}
+
+// Slot returns the stack in a window slot
+func (c *Container) Slot(slot int) (*ItemStack, error) {
+	if slot < 0 || slot >= c.SlotCount() {
+		return nil, ErrSlotOutOfRange
+	}
+	return c.slot(slot), nil
+}
+
+func (c *Container) slot(slot int) *ItemStack {
+	if c.Window == nil {
+		return &c.Player.Slots[slot]
+	}
+	if index, ok := c.Layout.InventorySlot(slot); ok {
+		return &c.Player.Slots[index]
+	}
+	if slot >= c.Layout.Size {
+		slot -= 36
+	}
+	return &c.Window.Slots[slot]
+}
+
+// Click applies a click and returns the changed slots
+func (c *Container) Click(click Click) (*ClickResult, error) {
+	if click.Slot != SlotOutside && (click.Slot < 0 || click.Slot >= c.SlotCount()) {
+		return nil, ErrSlotOutOfRange
+	}
+	if click.Mode != ClickDrag {
+		c.resetDrag()
+	}
+	if click.Slot != SlotOutside {
+		if r, ok := c.Layout.RangeAt(click.Slot); ok && r.Locked {
+			return &ClickResult{Cursor: c.Cursor}, nil
+		}
+	}
+
+	before := c.snapshot()
+	offhandBefore := c.Player.Slots[SlotOffhand]
+	result := &ClickResult{}
+
+	var err error
+	switch click.Mode {
+	case ClickPickup:
+		err = c.pickup(click, result)
+	case ClickQuickMove:
+		if click.Slot != SlotOutside {
+			c.quickMove(click.Slot)
+		}
+	case ClickSwap:
+		err = c.swap(click, result)
+	case ClickClone:
+		if c.Creative && c.Cursor.IsEmpty() && click.Slot != SlotOutside && !c.slot(click.Slot).IsEmpty() {
+			c.Cursor = *c.slot(click.Slot)
+			c.Cursor.Count = c.Cursor.MaxStackSize()
+		}
+	case ClickThrow:
+		c.throw(click, result)
+	case ClickDrag:
+		err = c.drag(click, result)
+	case ClickPickupAll:
+		c.pickupAll(click)
+	default:
+		err = ErrInvalidClick
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, old := range before {
+		if !sameStack(old, *c.slot(i)) {
+			result.Changes = append(result.Changes, SlotChange{Slot: i, Old: old, New: *c.slot(i)})
+		}
+	}
+	if c.Window != nil && !sameStack(offhandBefore, c.Player.Slots[SlotOffhand]) {
+		result.Offhand = &SlotChange{Slot: SlotOffhand, Old: offhandBefore, New: c.Player.Slots[SlotOffhand]}
+	}
+	result.Cursor = c.Cursor
+	return result, nil
+}
+
+func (c *Container) pickup(click Click, result *ClickResult) error {
+	if click.Button != 0 && click.Button != 1 {
+		return ErrInvalidClick
+	}
+
+	if click.Slot == SlotOutside {
+		if c.Cursor.IsEmpty() {
+			return nil
+		}
+		if click.Button == 0 {
+			result.Dropped = append(result.Dropped, c.Cursor)
+			c.Cursor = ItemStack{}
+		} else {
+			result.Dropped = append(result.Dropped, c.Cursor.split(1))
+		}
+		return nil
+	}
+
+	slot := c.slot(click.Slot)
+	switch {
+	case slot.IsEmpty():
+		if !c.Cursor.IsEmpty() {
+			count := c.Cursor.Count
+			if click.Button == 1 {
+				count = 1
+			}
+			c.safeInsert(click.Slot, count)
+		}
+	case c.Cursor.IsEmpty():
+		count := slot.Count
+		if click.Button == 1 && !c.takesWhole(click.Slot) {
+			count = (slot.Count + 1) / 2
+		}
+		taken := *slot
+		c.Cursor = slot.split(count)
+		c.onTake(click.Slot, taken)
+	case c.mayPlace(click.Slot, &c.Cursor):
+		if slot.CanStack(&c.Cursor) {
+			count := c.Cursor.Count
+			if click.Button == 1 {
+				count = 1
+			}
+			c.safeInsert(click.Slot, count)
+		} else if c.Cursor.Count <= c.slotLimit(click.Slot, &c.Cursor) {
+			*slot, c.Cursor = c.Cursor, *slot
+		}
+	case slot.CanStack(&c.Cursor):
+		// Output slots: take the result into the cursor if it fits
+		if c.Cursor.Count+slot.Count <= c.Cursor.MaxStackSize() {
+			taken := *slot
+			c.Cursor.Count += slot.Count
+			*slot = ItemStack{}
+			c.onTake(click.Slot, taken)
+		}
+	}
+	return nil
+}
+
+// safeInsert places up to count items from the cursor into a slot
+func (c *Container) safeInsert(index, count int) {
+	slot := c.slot(index)
+	if c.Cursor.IsEmpty() || !c.mayPlace(index, &c.Cursor) {
+		return
+	}
+	if !slot.IsEmpty() && !slot.CanStack(&c.Cursor) {
+		return
+	}
+
+	current := 0
+	if !slot.IsEmpty() {
+		current = slot.Count
+	}
+	count = min(count, c.Cursor.Count, c.slotLimit(index, &c.Cursor)-current)
+	if count <= 0 {
+		return
+	}
+	placed := c.Cursor.split(count)
+	if current == 0 {
+		*slot = placed
+	} else {
+		slot.Count += count
+	}
+}
+
+func (c *Container) swap(click Click, result *ClickResult) error {
+	if click.Slot == SlotOutside {
+		return nil
+	}
+
+	var other *ItemStack
+	switch {
+	case click.Button >= 0 && click.Button <= 8:
+		other = &c.Player.Slots[SlotHotbarStart+click.Button]
+	case click.Button == SwapOffhand:
+		other = &c.Player.Slots[SlotOffhand]
+	default:
+		return ErrInvalidClick
+	}
+
+	slot := c.slot(click.Slot)
+	if slot == other {
+		return nil
+	}
+	switch {
+	case other.IsEmpty() && slot.IsEmpty():
+	case other.IsEmpty():
+		*other = *slot
+		*slot = ItemStack{}
+		c.onTake(click.Slot, *other)
+	case slot.IsEmpty():
+		if c.mayPlace(click.Slot, other) {
+			limit := c.slotLimit(click.Slot, other)
+			if other.Count > limit {
+				*slot = other.split(limit)
+			} else {
+				*slot, *other = *other, ItemStack{}
+			}
+		}
+	case c.mayPlace(click.Slot, other):
+		taken := *slot
+		if limit := c.slotLimit(click.Slot, other); other.Count > limit {
+			// Only part of the stack fits: the old item goes to the inventory or is dropped
+			*slot = other.split(limit)
+			rest := taken
+			c.addToPlayer(&rest)
+			if !rest.IsEmpty() {
+				result.Dropped = append(result.Dropped, rest)
+			}
+		} else {
+			*slot, *other = *other, taken
+		}
+		c.onTake(click.Slot, taken)
+	}
+	return nil
+}
+
+// addToPlayer merges a stack into the player inventory the way picked up items
+// are: the held slot and offhand first, then the hotbar and main inventory, then
+// the first free slot. Whatever does not fit is left in stack.
+func (c *Container) addToPlayer(stack *ItemStack) {
+	order := make([]int, 0, 38)
+	order = append(order, SlotHotbarStart+c.Player.HeldSlot, SlotOffhand)
+	for i := SlotHotbarStart; i < SlotOffhand; i++ {
+		order = append(order, i)
+	}
+	for i := SlotMainStart; i < SlotHotbarStart; i++ {
+		order = append(order, i)
+	}
+
+	for _, i := range order {
+		slot := &c.Player.Slots[i]
+		if stack.IsEmpty() {
+			return
+		}
+		if !slot.CanStack(stack) {
+			continue
+		}
+		if take := min(stack.MaxStackSize()-slot.Count, stack.Count); take > 0 {
+			slot.Count += take
+			stack.split(take)
+		}
+	}
+	for _, i := range order[2:] {
+		if stack.IsEmpty() {
+			return
+		}
+		if slot := &c.Player.Slots[i]; slot.IsEmpty() {
+			*slot = stack.split(stack.Count)
+		}
+	}
+}
+
+func (c *Container) throw(click Click, result *ClickResult) {
+	if click.Slot == SlotOutside || !c.Cursor.IsEmpty() {
+		return
+	}
+	slot := c.slot(click.Slot)
+	if slot.IsEmpty() {
+		return
+	}
+	count := 1
+	if click.Button == 1 || c.takesWhole(click.Slot) {
+		count = slot.Count
+	}
+	taken := *slot
+	result.Dropped = append(result.Dropped, slot.split(count))
+	c.onTake(click.Slot, taken)
+}
+
+func (c *Container) drag(click Click, result *ClickResult) error {
+	dragType := (click.Button >> 2) & 3
+	switch click.Button & 3 {
+	case 0: // Start
+		c.resetDrag()
+		if c.Cursor.IsEmpty() || dragType > 2 || (dragType == 2 && !c.Creative) {
+			return nil
+		}
+		c.dragging = true
+		c.dragType = dragType
+
+	case 1: // Add slot
+		if !c.dragging || dragType != c.dragType || click.Slot == SlotOutside {
+			return nil
+		}
+		for _, s := range c.dragSlots {
+			if s == click.Slot {
+				return nil
+			}
+		}
+		if c.canDragTo(click.Slot) && (c.dragType == 2 || c.Cursor.Count > len(c.dragSlots)) {
+			c.dragSlots = append(c.dragSlots, click.Slot)
+		}
+
+	case 2: // End
+		if !c.dragging || dragType != c.dragType {
+			c.resetDrag()
+			return nil
+		}
+		slots, kind := c.dragSlots, c.dragType
+		c.resetDrag()
+		if len(slots) == 0 {
+			return nil
+		}
+		if len(slots) == 1 {
+			if kind == 2 {
+				return nil
+			}
+			return c.pickup(Click{Slot: slots[0], Button: kind, Mode: ClickPickup}, result)
+		}
+		c.distribute(slots, kind)
+
+	default:
+		return ErrInvalidClick
+	}
+	return nil
+}
+
+// distribute spreads the cursor over the dragged slots
+func (c *Container) distribute(slots []int, kind int) {
+	template := c.Cursor
+	remaining := c.Cursor.Count
+	for _, index := range slots {
+		if !c.canDragTo(index) || (kind != 2 && template.Count < len(slots)) {
+			continue
+		}
+		slot := c.slot(index)
+		current := 0
+		if !slot.IsEmpty() {
+			current = slot.Count
+		}
+
+		var per int
+		switch kind {
+		case 0:
+			per = template.Count / len(slots)
+		case 1:
+			per = 1
+		case 2:
+			per = template.MaxStackSize()
+		}
+		count := min(per+current, c.slotLimit(index, &template))
+		remaining -= count - current
+		*slot = template
+		slot.Count = count
+	}
+
+	c.Cursor.Count = remaining
+	if remaining <= 0 {
+		c.Cursor = ItemStack{}
+	}
+}
+
+// canDragTo checks if the cursor stack may be dragged over a slot
+func (c *Container) canDragTo(index int) bool {
+	slot := c.slot(index)
+	if !slot.IsEmpty() && !slot.CanStack(&c.Cursor) {
+		return false
+	}
+	return c.mayPlace(index, &c.Cursor)
+}
+
+func (c *Container) resetDrag() {
+	c.dragging = false
+	c.dragType = 0
+	c.dragSlots = nil
+}
+
+func (c *Container) pickupAll(click Click) {
+	if c.Cursor.IsEmpty() || click.Slot == SlotOutside {
+		return
+	}
+	if clicked := c.slot(click.Slot); !clicked.IsEmpty() {
+		return
+	}
+
+	count := c.SlotCount()
+	start, step := 0, 1
+	if click.Button == 1 {
+		start, step = count-1, -1
+	}
+	// First pass takes from partial stacks, the second from full ones
+	for pass := 0; pass < 2; pass++ {
+		for i := start; i >= 0 && i < count && c.Cursor.Count < c.Cursor.MaxStackSize(); i += step {
+			slot := c.slot(i)
+			if slot.IsEmpty() || !slot.CanStack(&c.Cursor) || c.isOutput(i) {
+				continue
+			}
+			if pass == 0 && slot.Count == slot.MaxStackSize() {
+				continue
+			}
+			take := min(c.Cursor.MaxStackSize()-c.Cursor.Count, slot.Count)
+			slot.split(take)
+			c.Cursor.Count += take
+		}
+	}
+}
+
+// quickMove moves a stack to its shift-click destination
+func (c *Container) quickMove(index int) {
+	slot := c.slot(index)
+	if slot.IsEmpty() {
+		return
+	}
+
+	l := c.Layout
+	main, _ := l.Range(RangeMain)
+	hotbar, _ := l.Range(RangeHotbar)
+	if _, ok := c.craftGrid(index); ok {
+		c.quickCraft(index, slotRange{main.Start, hotbar.End(), true})
+		return
+	}
+	if !l.IsPlayerSlot(index) {
+		r, _ := l.RangeAt(index)
+		c.moveInto(slot, slotRange{main.Start, hotbar.End(), l.Storage || r.Output})
+		return
+	}
+	if l.Storage {
+		c.moveInto(slot, slotRange{0, l.Size, false})
+		return
+	}
+
+	for _, route := range l.Routes {
+		target, ok := l.routeRange(route)
+		if !ok || !c.accepts(route.Rule, slot) || (route.IfEmpty && !c.slot(target.Start).IsEmpty()) {
+			continue
+		}
+		if c.moveInto(slot, target) || !l.Fallback {
+			return
+		}
+		break
+	}
+	if main.Contains(index) {
+		c.moveInto(slot, slotRange{hotbar.Start, hotbar.End(), false})
+	} else {
+		c.moveInto(slot, slotRange{main.Start, main.End(), false})
+	}
+}
+
+// slotRange is a half-open range of window slots
+type slotRange struct {
+	Start, End int
+	Reverse    bool // Fill from the end of the range
+}
+
+// moveInto merges a stack into a range of slots, then places the rest into the first empty slot
+func (c *Container) moveInto(stack *ItemStack, r slotRange) bool {
+	moved := false
+	indices := make([]int, 0, r.End-r.Start)
+	for i := r.Start; i < r.End; i++ {
+		indices = append(indices, i)
+	}
+	if r.Reverse {
+		for i, j := 0, len(indices)-1; i < j; i, j = i+1, j-1 {
+			indices[i], indices[j] = indices[j], indices[i]
+		}
+	}
+
+	if stack.MaxStackSize() > 1 {
+		for _, i := range indices {
+			if stack.IsEmpty() {
+				break
+			}
+			slot := c.slot(i)
+			if slot.IsEmpty() || !slot.CanStack(stack) || !c.mayPlace(i, stack) {
+				continue
+			}
+			limit := min(stack.MaxStackSize(), c.slotLimit(i, stack))
+			if take := min(limit-slot.Count, stack.Count); take > 0 {
+				slot.Count += take
+				stack.split(take)
+				moved = true
+			}
+		}
+	}
+
+	if !stack.IsEmpty() {
+		for _, i := range indices {
+			slot := c.slot(i)
+			if !slot.IsEmpty() || !c.mayPlace(i, stack) {
+				continue
+			}
+			*slot = stack.split(min(stack.Count, c.slotLimit(i, stack)))
+			moved = true
+			break
+		}
+	}
+	return moved
+}
+
+// quickCraft shift-clicks a crafting result, crafting again while the grid still
+// yields the same item and the inventory has room
+func (c *Container) quickCraft(index int, r slotRange) {
+	result := c.slot(index)
+	for !result.IsEmpty() {
+		taken := *result
+		moving := *result
+		c.moveInto(&moving, r)
+		if moving.Count == taken.Count {
+			return
+		}
+		*result = moving
+		c.onTake(index, taken)
+		if !moving.IsEmpty() || result.IsEmpty() || result.Item.Name != taken.Item.Name {
+			return
+		}
+	}
+}
+
+// takesWhole reports whether taking from an output slot always takes the whole stack
+func (c *Container) takesWhole(index int) bool {
+	r, ok := c.Layout.RangeAt(index)
+	return ok && r.Output && !r.Partial
+}
+
+// onTake updates the window after a result stack was taken from a slot
+func (c *Container) onTake(index int, taken ItemStack) {
+	grid, ok := c.craftGrid(index)
+	if !ok {
+		return
+	}
+
+	// Every grid cell gives one item; crafting remainders (e.g. empty buckets) are left to the server
+	emptied := false
+	for i := grid.Start; i < grid.End(); i++ {
+		if slot := c.slot(i); !slot.IsEmpty() {
+			slot.split(1)
+			emptied = emptied || slot.IsEmpty()
+		}
+	}
+
+	// The same items in the same cells craft the same result again
+	result := c.slot(index)
+	*result = ItemStack{}
+	if !emptied {
+		*result = taken
+		return
+	}
+	if c.Recipes == nil {
+		return
+	}
+	items := make([]ItemStack, grid.Count)
+	for i := range items {
+		items[i] = *c.slot(grid.Start + i)
+	}
+	size := 2
+	if grid.Count == 9 {
+		size = 3
+	}
+	if recipe, ok := c.Recipes.MatchGrid(items, size); ok && recipe.Result.Item == taken.Item.Name {
+		*result = taken
+		result.Count = recipe.Result.Count
+	}
+}
+
+// craftGrid returns the crafting grid fed by a crafting result slot
+func (c *Container) craftGrid(index int) (SlotRange, bool) {
+	r, ok := c.Layout.RangeAt(index)
+	if !ok || r.Name != RangeResult || r.Locked {
+		return SlotRange{}, false
+	}
+	return c.Layout.Range(RangeGrid)
+}
+
+// isOutput reports whether a slot is a result slot that cannot be placed into
+func (c *Container) isOutput(index int) bool {
+	r, ok := c.Layout.RangeAt(index)
+	return ok && (r.Output || r.Locked)
+}
+
+// mayPlace checks if a stack may be placed into a slot
+func (c *Container) mayPlace(index int, stack *ItemStack) bool {
+	r, ok := c.Layout.RangeAt(index)
+	if !ok || r.Output || r.Locked {
+		return false
+	}
+	return c.accepts(r.Rule, stack)
+}
+
+// slotLimit returns the maximum number of items of a stack a slot can hold
+func (c *Container) slotLimit(index int, stack *ItemStack) int {
+	if r, ok := c.Layout.RangeAt(index); ok && r.MaxStack > 0 {
+		return min(r.MaxStack, stack.MaxStackSize())
+	}
+	return stack.MaxStackSize()
+}
+
+// accepts checks a stack against a slot rule, preferring the container's overrides
+func (c *Container) accepts(rule SlotRule, stack *ItemStack) bool {
+	if rule == RuleAny {
+		return true
+	}
+	if match, ok := c.Rules[rule]; ok {
+		return match(stack)
+	}
+	if match, ok := DefaultRules[rule]; ok {
+		return match(stack)
+	}
+	return true
+}
+
+func (c *Container) snapshot() []ItemStack {
+	snapshot := make([]ItemStack, c.SlotCount())
+	for i := range snapshot {
+		snapshot[i] = *c.slot(i)
+	}
+	return snapshot
+}
+
+// split removes count items from the stack and returns them as a new stack
+func (s *ItemStack) split(count int) ItemStack {
+	count = min(count, s.Count)
+	taken := *s
+	taken.Count = count
+	s.Count -= count
+	if s.Count <= 0 {
+		*s = ItemStack{}
+	}
+	return taken
+}
+
+// sameStack reports whether two stacks have identical contents
+func sameStack(a, b ItemStack) bool {
+	if a.IsEmpty() && b.IsEmpty() {
+		return true
+	}
+	return a.Item.ID == b.Item.ID && a.Count == b.Count && a.SameComponents(&b)
+}
@@ -0,0 +1,367 @@
+package inventory
+
+import (
+	"maps"
+	"reflect"
+
+	"github.com/konjacbot/prismarine-go/chat"
+)
+
+// Data component types (data_component_type registry names)
+const (
+	ComponentCustomData         = "minecraft:custom_data"
+	ComponentMaxStackSize       = "minecraft:max_stack_size"
+	ComponentMaxDamage          = "minecraft:max_damage"
+	ComponentDamage             = "minecraft:damage"
+	ComponentUnbreakable        = "minecraft:unbreakable"
+	ComponentCustomName         = "minecraft:custom_name"
+	ComponentItemName           = "minecraft:item_name"
+	ComponentLore               = "minecraft:lore"
+	ComponentEnchantments       = "minecraft:enchantments"
+	ComponentStoredEnchantments = "minecraft:stored_enchantments"
+	ComponentAttributeModifiers = "minecraft:attribute_modifiers"
+	ComponentRepairCost         = "minecraft:repair_cost"
+	ComponentFood               = "minecraft:food"
+	ComponentTool               = "minecraft:tool"
+	ComponentDyedColor          = "minecraft:dyed_color"
+	ComponentPotionContents     = "minecraft:potion_contents"
+	ComponentContainer          = "minecraft:container"
+	ComponentBundleContents     = "minecraft:bundle_contents"
+	ComponentEquippable         = "minecraft:equippable"
+	ComponentRarity             = "minecraft:rarity"
+	ComponentDamageResistant    = "minecraft:damage_resistant"
+	ComponentEnchantable        = "minecraft:enchantable"
+	ComponentRepairable         = "minecraft:repairable"
+)
+
+// Equipment slot names used by the equippable component
+const (
+	EquipMainhand = "mainhand"
+	EquipOffhand  = "offhand"
+	EquipHead     = "head"
+	EquipChest    = "chest"
+	EquipLegs     = "legs"
+	EquipFeet     = "feet"
+	EquipBody     = "body"
+	EquipSaddle   = "saddle"
+)
+
+// Rarity is the rarity component (name color)
+type Rarity string
+
+const (
+	RarityCommon   Rarity = "common"
+	RarityUncommon Rarity = "uncommon"
+	RarityRare     Rarity = "rare"
+	RarityEpic     Rarity = "epic"
+)
+
+// DamageTypeFire is the damage_resistant value of items that survive fire and lava
+const DamageTypeFire = "#minecraft:is_fire"
+
+// Components maps component types to their values. Known components use the
+// typed values below; unknown ones hold a RawComponent or any decoded value.
+type Components map[string]any
+
+// RawComponent is the protocol-encoded value of a component this package does not model
+type RawComponent []byte
+
+// ComponentPatch is the difference between a stack's components and its item's prototype
+type ComponentPatch struct {
+	Added   Components      // Components set or overridden on the stack
+	Removed map[string]bool // Prototype components removed from the stack
+}
+
+// IsEmpty returns true if the patch changes nothing
+func (p ComponentPatch) IsEmpty() bool {
+	return len(p.Added) == 0 && len(p.Removed) == 0
+}
+
+// Enchantments maps enchantment names (e.g. "minecraft:sharpness") to levels
+type Enchantments map[string]int
+
+// AttributeOperation is how an attribute modifier is applied
+type AttributeOperation int
+
+const (
+	AttributeAddValue           AttributeOperation = iota // Add to the base value
+	AttributeAddMultipliedBase                            // Add amount * base value
+	AttributeAddMultipliedTotal                           // Multiply the total by 1 + amount
+)
+
+// AttributeModifier is an entry of the attribute_modifiers component
+type AttributeModifier struct {
+	Type      string             // Attribute name (e.g. "minecraft:attack_damage")
+	ID        string             // Modifier ID (e.g. "minecraft:base_attack_damage")
+	Amount    float64            // Modifier amount
+	Operation AttributeOperation // How the amount is applied
+	Slot      string             // Equipment slot group ("any", "mainhand", "armor", ...)
+}
+
+// Food is the food component
+type Food struct {
+	Nutrition    int     // Hunger points restored
+	Saturation   float64 // Saturation restored
+	CanAlwaysEat bool    // Edible with a full hunger bar
+}
+
+// ToolRule sets the mining speed and drop behavior for a set of blocks
+type ToolRule struct {
+	Blocks          []string // Block names or tags ("#minecraft:mineable/pickaxe")
+	Speed           *float64 // Mining speed (nil keeps the default)
+	CorrectForDrops *bool    // Whether the blocks drop items (nil keeps the default)
+}
+
+// Tool is the tool component
+type Tool struct {
+	Rules              []ToolRule // Rules, first match wins
+	DefaultMiningSpeed float64    // Speed for blocks matching no rule
+	DamagePerBlock     int        // Durability used per block mined
+}
+
+// EffectInstance is a status effect carried by an item
+type EffectInstance struct {
+	ID            string // Effect name (e.g. "minecraft:speed")
+	Amplifier     int    // Level minus one
+	Duration      int    // Duration in ticks (-1 for infinite)
+	Ambient       bool   // From a beacon
+	ShowParticles bool   // Particles are visible
+	ShowIcon      bool   // Icon is shown in the HUD
+}
+
+// PotionContents is the potion_contents component
+type PotionContents struct {
+	Potion        string           // Base potion (e.g. "minecraft:swiftness"), empty if none
+	CustomColor   *int             // RGB color override
+	CustomEffects []EffectInstance // Effects in addition to the base potion
+	CustomName    string           // Translation key suffix override
+}
+
+// Equippable is the equippable component
+type Equippable struct {
+	Slot string // Equipment slot (e.g. EquipHead)
+}
+
+// Component returns the effective value of a component, taking the stack's
+// patch over the item's prototype
+func (s *ItemStack) Component(id string) (any, bool) {
+	if s.Components.Removed[id] {
+		return nil, false
+	}
+	if v, ok := s.Components.Added[id]; ok {
+		return v, true
+	}
+	v, ok := s.Item.Prototype[id]
+	return v, ok
+}
+
+// HasComponent checks if the stack has a component
+func (s *ItemStack) HasComponent(id string) bool {
+	_, ok := s.Component(id)
+	return ok
+}
+
+// SetComponent sets a component on the stack. The patch is copied first so
+// stacks sharing a patch are not affected.
+func (s *ItemStack) SetComponent(id string, value any) {
+	added := maps.Clone(s.Components.Added)
+	removed := maps.Clone(s.Components.Removed)
+	delete(removed, id)
+	if proto, ok := s.Item.Prototype[id]; ok && reflect.DeepEqual(proto, value) {
+		delete(added, id)
+	} else {
+		if added == nil {
+			added = Components{}
+		}
+		added[id] = value
+	}
+	s.Components = ComponentPatch{Added: added, Removed: removed}
+}
+
+// RemoveComponent removes a component from the stack
+func (s *ItemStack) RemoveComponent(id string) {
+	added := maps.Clone(s.Components.Added)
+	removed := maps.Clone(s.Components.Removed)
+	delete(added, id)
+	if _, ok := s.Item.Prototype[id]; ok {
+		if removed == nil {
+			removed = map[string]bool{}
+		}
+		removed[id] = true
+	}
+	s.Components = ComponentPatch{Added: added, Removed: removed}
+}
+
+// ResetComponent restores a component to the item's prototype value
+func (s *ItemStack) ResetComponent(id string) {
+	added := maps.Clone(s.Components.Added)
+	removed := maps.Clone(s.Components.Removed)
+	delete(added, id)
+	delete(removed, id)
+	s.Components = ComponentPatch{Added: added, Removed: removed}
+}
+
+// SameComponents checks if two stacks of the same item have the same effective components
+func (s *ItemStack) SameComponents(other *ItemStack) bool {
+	check := func(id string) bool {
+		a, okA := s.Component(id)
+		b, okB := other.Component(id)
+		return okA == okB && reflect.DeepEqual(a, b)
+	}
+	for _, stack := range []*ItemStack{s, other} {
+		for id := range stack.Components.Added {
+			if !check(id) {
+				return false
+			}
+		}
+		for id := range stack.Components.Removed {
+			if !check(id) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func component[T any](s *ItemStack, id string) (T, bool) {
+	v, ok := s.Component(id)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	typed, ok := v.(T)
+	return typed, ok
+}
+
+// CustomName returns the name given in an anvil, or nil
+func (s *ItemStack) CustomName() *chat.Message {
+	name, _ := component[*chat.Message](s, ComponentCustomName)
+	return name
+}
+
+// Lore returns the lore lines
+func (s *ItemStack) Lore() []chat.Message {
+	lore, _ := component[[]chat.Message](s, ComponentLore)
+	return lore
+}
+
+// Damage returns the durability used
+func (s *ItemStack) Damage() int {
+	damage, _ := component[int](s, ComponentDamage)
+	return damage
+}
+
+// MaxDamage returns the maximum durability (0 if the item cannot be damaged)
+func (s *ItemStack) MaxDamage() int {
+	maxDamage, _ := component[int](s, ComponentMaxDamage)
+	return maxDamage
+}
+
+// Durability returns the remaining durability (0 if the item cannot be damaged)
+func (s *ItemStack) Durability() int {
+	return max(s.MaxDamage()-s.Damage(), 0)
+}
+
+// Unbreakable returns true if the item never takes durability damage
+func (s *ItemStack) Unbreakable() bool {
+	return s.HasComponent(ComponentUnbreakable)
+}
+
+// Enchantments returns the enchantments applied to the item
+func (s *ItemStack) Enchantments() Enchantments {
+	enchantments, _ := component[Enchantments](s, ComponentEnchantments)
+	return enchantments
+}
+
+// StoredEnchantments returns the enchantments stored in an enchanted book
+func (s *ItemStack) StoredEnchantments() Enchantments {
+	enchantments, _ := component[Enchantments](s, ComponentStoredEnchantments)
+	return enchantments
+}
+
+// AttributeModifiers returns the attribute modifiers of the item
+func (s *ItemStack) AttributeModifiers() []AttributeModifier {
+	modifiers, _ := component[[]AttributeModifier](s, ComponentAttributeModifiers)
+	return modifiers
+}
+
+// RepairCost returns the extra anvil cost accumulated by repairs
+func (s *ItemStack) RepairCost() int {
+	cost, _ := component[int](s, ComponentRepairCost)
+	return cost
+}
+
+// Food returns the food component, or nil if the item is not edible
+func (s *ItemStack) Food() *Food {
+	food, _ := component[*Food](s, ComponentFood)
+	return food
+}
+
+// Tool returns the tool component, or nil
+func (s *ItemStack) Tool() *Tool {
+	tool, _ := component[*Tool](s, ComponentTool)
+	return tool
+}
+
+// DyedColor returns the RGB dye color of leather armor
+func (s *ItemStack) DyedColor() (int, bool) {
+	return component[int](s, ComponentDyedColor)
+}
+
+// PotionContents returns the potion contents, or nil
+func (s *ItemStack) PotionContents() *PotionContents {
+	contents, _ := component[*PotionContents](s, ComponentPotionContents)
+	return contents
+}
+
+// ContainerContents returns the items stored in a shulker box or other container item
+func (s *ItemStack) ContainerContents() []ItemStack {
+	contents, _ := component[[]ItemStack](s, ComponentContainer)
+	return contents
+}
+
+// BundleContents returns the items stored in a bundle
+func (s *ItemStack) BundleContents() []ItemStack {
+	contents, _ := component[[]ItemStack](s, ComponentBundleContents)
+	return contents
+}
+
+// CustomData returns the free-form custom_data NBT compound
+func (s *ItemStack) CustomData() map[string]interface{} {
+	data, _ := component[map[string]interface{}](s, ComponentCustomData)
+	return data
+}
+
+// EquipmentSlot returns the slot the item is worn in (empty if it cannot be equipped)
+func (s *ItemStack) EquipmentSlot() string {
+	if equippable, _ := component[*Equippable](s, ComponentEquippable); equippable != nil {
+		return equippable.Slot
+	}
+	return ""
+}
+
+// Rarity returns the item rarity
+func (s *ItemStack) Rarity() Rarity {
+	if rarity, ok := component[Rarity](s, ComponentRarity); ok {
+		return rarity
+	}
+	return RarityCommon
+}
+
+// FireResistant returns true if the item survives fire and lava
+func (s *ItemStack) FireResistant() bool {
+	resistant, _ := component[string](s, ComponentDamageResistant)
+	return resistant == DamageTypeFire
+}
+
+// Enchantability returns the enchanting table value (0 if not enchantable)
+func (s *ItemStack) Enchantability() int {
+	value, _ := component[int](s, ComponentEnchantable)
+	return value
+}
+
+// RepairableWith returns the item or tag ("#...") that repairs the item in an anvil
+func (s *ItemStack) RepairableWith() string {
+	repair, _ := component[string](s, ComponentRepairable)
+	return repair
+}
@@ -0,0 +1,204 @@
+package inventory
+
+import (
+	"math"
+	"strings"
+
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// EnchantEfficiency and EnchantAquaAffinity are the enchantments that change mining speed
+const (
+	EnchantEfficiency   = "minecraft:efficiency"
+	EnchantAquaAffinity = "minecraft:aqua_affinity"
+)
+
+// DigUnbreakable is returned by DigTicks for blocks that can never be broken
+const DigUnbreakable = -1
+
+// BlockTags resolves block tags used by tool rules. Tag names have no "#" prefix.
+type BlockTags interface {
+	BlockHasTag(id int, tag string) bool // Checks if a block is in a tag
+}
+
+// DigConditions is the player state that affects mining speed
+type DigConditions struct {
+	Haste         int       // Haste level (amplifier + 1, 0 if none; Conduit Power counts as Haste)
+	MiningFatigue int       // Mining Fatigue level (amplifier + 1, 0 if none)
+	Underwater    bool      // Eyes are submerged in water
+	AquaAffinity  bool      // Worn helmet has Aqua Affinity
+	OnGround      bool      // Standing on the ground
+	Creative      bool      // Creative mode breaks blocks instantly
+	Tags          BlockTags // Resolves tool rule tags (nil matches block names only)
+}
+
+// ToolChoice is the item picked to mine a block
+type ToolChoice struct {
+	Slot    int  // Inventory slot of the tool (-1 if no tool beats an empty hand)
+	Ticks   int  // Ticks to break the block
+	Harvest bool // Whether the block drops items
+}
+
+// matchesRule checks if a tool rule applies to a block
+func matchesRule(rule ToolRule, block *world.BlockInfo, tags BlockTags) bool {
+	for _, entry := range rule.Blocks {
+		if tag, ok := strings.CutPrefix(entry, "#"); ok {
+			if tags != nil && tags.BlockHasTag(block.ID, tag) {
+				return true
+			}
+		} else if entry == block.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// DigSpeed returns the base mining speed of the stack against a block (1 for a bare
+// hand or a non-tool) and whether it is the correct tool for drops
+func (s *ItemStack) DigSpeed(block *world.BlockInfo, tags BlockTags) (speed float64, correct bool) {
+	speed = 1
+	var tool *Tool
+	if s != nil && !s.IsEmpty() {
+		tool = s.Tool()
+	}
+	if tool == nil {
+		return speed, false
+	}
+
+	speed = tool.DefaultMiningSpeed
+	speedSet, correctSet := false, false
+	for _, rule := range tool.Rules {
+		if (speedSet || rule.Speed == nil) && (correctSet || rule.CorrectForDrops == nil) {
+			continue
+		}
+		if !matchesRule(rule, block, tags) {
+			continue
+		}
+		if !speedSet && rule.Speed != nil {
+			speed, speedSet = *rule.Speed, true
+		}
+		if !correctSet && rule.CorrectForDrops != nil {
+			correct, correctSet = *rule.CorrectForDrops, true
+		}
+	}
+	return speed, correct
+}
+
+// CanHarvest checks if a block drops items when mined with the stack
+func CanHarvest(block *world.BlockInfo, held *ItemStack, tags BlockTags) bool {
+	if !block.RequiresTool {
+		return true
+	}
+	_, correct := held.DigSpeed(block, tags)
+	return correct
+}
+
+// DigTicks returns the ticks needed to break a block with the held stack (nil or
+// empty for a bare hand): 0 for instant breaking, DigUnbreakable if never.
+// The 5-tick delay between consecutive blocks is not included.
+func DigTicks(block *world.BlockInfo, held *ItemStack, cond DigConditions) int {
+	if block.Hardness < 0 {
+		return DigUnbreakable
+	}
+	if cond.Creative || block.Hardness == 0 {
+		return 0
+	}
+
+	speed, correct := held.DigSpeed(block, cond.Tags)
+	if speed > 1 && held != nil {
+		if level := held.Enchantments()[EnchantEfficiency]; level > 0 {
+			speed += float64(level*level + 1)
+		}
+	}
+	if cond.Haste > 0 {
+		speed *= 1 + 0.2*float64(cond.Haste)
+	}
+	if cond.MiningFatigue > 0 {
+		speed *= miningFatigueFactor(cond.MiningFatigue)
+	}
+	if cond.Underwater && !cond.AquaAffinity {
+		speed *= 0.2
+	}
+	if !cond.OnGround {
+		speed *= 0.2
+	}
+
+	divisor := 100.0
+	if !block.RequiresTool || correct {
+		divisor = 30
+	}
+	progress := speed / block.Hardness / divisor
+	if progress >= 1 {
+		return 0
+	}
+	if progress <= 0 {
+		return DigUnbreakable
+	}
+	return int(math.Ceil(1 / progress))
+}
+
+// miningFatigueFactor returns the speed multiplier for a Mining Fatigue level
+func miningFatigueFactor(level int) float64 {
+	switch level {
+	case 1:
+		return 0.3
+	case 2:
+		return 0.09
+	case 3:
+		return 0.0027
+	}
+	return 0.00081
+}
+
+// BestToolFor picks the inventory item that breaks a block fastest, preferring
+// items that harvest it, then the held item and the hotbar (no swap needed).
+// Damageable items with minDurability or less durability left are skipped.
+// It returns false if the block cannot be broken.
+func (inv *Inventory) BestToolFor(block *world.BlockInfo, cond DigConditions, minDurability int) (ToolChoice, bool) {
+	best := ToolChoice{
+		Slot:    -1,
+		Ticks:   DigTicks(block, nil, cond),
+		Harvest: CanHarvest(block, nil, cond.Tags),
+	}
+
+	slots := []int{SlotHotbarStart + inv.HeldSlot}
+	for i := SlotHotbarStart; i < SlotHotbarStart+9; i++ {
+		slots = append(slots, i)
+	}
+	for i := SlotMainStart; i < SlotHotbarStart; i++ {
+		slots = append(slots, i)
+	}
+
+	for _, slot := range slots {
+		if slot < 0 || slot >= inv.Size {
+			continue
+		}
+		stack := &inv.Slots[slot]
+		if stack.IsEmpty() || stack.Tool() == nil {
+			continue
+		}
+		if stack.MaxDamage() > 0 && !stack.Unbreakable() && stack.Durability() <= minDurability {
+			continue
+		}
+		choice := ToolChoice{
+			Slot:    slot,
+			Ticks:   DigTicks(block, stack, cond),
+			Harvest: CanHarvest(block, stack, cond.Tags),
+		}
+		if betterTool(choice, best) {
+			best = choice
+		}
+	}
+	return best, best.Ticks != DigUnbreakable
+}
+
+// betterTool reports whether a strictly beats b
+func betterTool(a, b ToolChoice) bool {
+	if a.Ticks == DigUnbreakable {
+		return false
+	}
+	if a.Harvest != b.Harvest {
+		return a.Harvest
+	}
+	return b.Ticks == DigUnbreakable || a.Ticks < b.Ticks
+}
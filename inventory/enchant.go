@@ -0,0 +1,454 @@
+package inventory
+
+import (
+	"maps"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Enchantments referenced by combat and damage formulas
+const (
+	EnchantProtection           = "minecraft:protection"
+	EnchantFireProtection       = "minecraft:fire_protection"
+	EnchantBlastProtection      = "minecraft:blast_protection"
+	EnchantProjectileProtection = "minecraft:projectile_protection"
+	EnchantFeatherFalling       = "minecraft:feather_falling"
+	EnchantSharpness            = "minecraft:sharpness"
+	EnchantSmite                = "minecraft:smite"
+	EnchantBaneOfArthropods     = "minecraft:bane_of_arthropods"
+	EnchantImpaling             = "minecraft:impaling"
+	EnchantUnbreaking           = "minecraft:unbreaking"
+)
+
+// MaxAnvilCost is the level cost at which survival anvils refuse a job ("Too Expensive!")
+const MaxAnvilCost = 40
+
+// EnchantCost is a level-dependent enchanting table cost
+type EnchantCost struct {
+	Base     int // Cost at level 1
+	PerLevel int // Added per level above 1
+}
+
+// At returns the cost at an enchantment level
+func (c EnchantCost) At(level int) int {
+	return c.Base + c.PerLevel*(level-1)
+}
+
+// EnchantmentInfo describes an enchantment type
+type EnchantmentInfo struct {
+	ID             int         // Registry ID
+	Name           string      // Enchantment name (e.g. "minecraft:sharpness")
+	MaxLevel       int         // Maximum level
+	Weight         int         // Enchanting table weight
+	AnvilCost      int         // Anvil cost per level (halved for books)
+	MinCost        EnchantCost // Minimum enchanting power per level
+	MaxCost        EnchantCost // Maximum enchanting power per level
+	Slots          []string    // Equipment slot groups where it is active
+	SupportedItems string      // Items it can be applied to (item or "#tag")
+	PrimaryItems   string      // Items offered it at the enchanting table (empty = SupportedItems)
+	ExclusiveSet   []string    // Enchantments it cannot be combined with
+	Treasure       bool        // Not offered by the enchanting table
+	Curse          bool        // Curse
+}
+
+// EnchantmentRegistry holds enchantment definitions and the item tags their rules use
+type EnchantmentRegistry struct {
+	Enchantments map[string]*EnchantmentInfo // Enchantments by name
+	Tags         ItemTags                    // Tag resolver for supported and primary items
+}
+
+// NewEnchantmentRegistry creates an enchantment registry
+func NewEnchantmentRegistry(enchantments []EnchantmentInfo, tags ItemTags) *EnchantmentRegistry {
+	reg := &EnchantmentRegistry{Enchantments: make(map[string]*EnchantmentInfo), Tags: tags}
+	for i := range enchantments {
+		reg.Enchantments[enchantments[i].Name] = &enchantments[i]
+	}
+	return reg
+}
+
+// Get returns an enchantment by name
+func (reg *EnchantmentRegistry) Get(name string) (*EnchantmentInfo, bool) {
+	e, ok := reg.Enchantments[name]
+	return e, ok
+}
+
+// sorted returns the enchantments ordered by registry ID
+func (reg *EnchantmentRegistry) sorted() []*EnchantmentInfo {
+	list := make([]*EnchantmentInfo, 0, len(reg.Enchantments))
+	for _, e := range reg.Enchantments {
+		list = append(list, e)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	return list
+}
+
+func (reg *EnchantmentRegistry) matchItems(items, item string) bool {
+	return Ingredient{items}.Matches(item, reg.Tags)
+}
+
+// Supports checks if an enchantment can be applied to an item
+func (reg *EnchantmentRegistry) Supports(e *EnchantmentInfo, item string) bool {
+	return reg.matchItems(e.SupportedItems, item)
+}
+
+// Compatible checks if two different enchantments can be on the same item
+func (reg *EnchantmentRegistry) Compatible(a, b string) bool {
+	if a == b {
+		return false
+	}
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		if e, ok := reg.Enchantments[pair[0]]; ok {
+			for _, other := range e.ExclusiveSet {
+				if other == pair[1] {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
+
+// IsEnchantedBook checks if the stack stores enchantments instead of applying them
+func (s *ItemStack) IsEnchantedBook() bool {
+	return s.Item.Name == "minecraft:enchanted_book"
+}
+
+// AllEnchantments returns the applied enchantments, or the stored ones for enchanted books
+func (s *ItemStack) AllEnchantments() Enchantments {
+	if s.IsEnchantedBook() {
+		return s.StoredEnchantments()
+	}
+	return s.Enchantments()
+}
+
+// EnchantmentLevel returns the level of an enchantment (0 if absent)
+func (s *ItemStack) EnchantmentLevel(name string) int {
+	return s.AllEnchantments()[name]
+}
+
+// SetEnchantments replaces the enchantments (stored enchantments for books)
+func (s *ItemStack) SetEnchantments(enchantments Enchantments) {
+	id := ComponentEnchantments
+	if s.IsEnchantedBook() {
+		id = ComponentStoredEnchantments
+	}
+	if len(enchantments) == 0 {
+		s.RemoveComponent(id)
+		return
+	}
+	s.SetComponent(id, enchantments)
+}
+
+// AddEnchantment sets an enchantment level, keeping the others (level <= 0 removes it)
+func (s *ItemStack) AddEnchantment(name string, level int) {
+	enchantments := maps.Clone(s.AllEnchantments())
+	if enchantments == nil {
+		enchantments = Enchantments{}
+	}
+	if level <= 0 {
+		delete(enchantments, name)
+	} else {
+		enchantments[name] = level
+	}
+	s.SetEnchantments(enchantments)
+}
+
+// AnvilResult is the outcome of an anvil job
+type AnvilResult struct {
+	Result       ItemStack // Output item
+	Cost         int       // Level cost, including the prior work penalty
+	MaterialUsed int       // Sacrifice items consumed (repair materials use more than one)
+	TooExpensive bool      // Cost reaches MaxAnvilCost (survival only)
+}
+
+// Combine simulates putting target and sacrifice into an anvil: merging enchantments,
+// repairing with a second item or with repair materials, and applying the prior work
+// penalty. It returns false if the anvil produces nothing.
+func (reg *EnchantmentRegistry) Combine(target, sacrifice *ItemStack, creative bool) (AnvilResult, bool) {
+	if target.IsEmpty() || sacrifice.IsEmpty() {
+		return AnvilResult{}, false
+	}
+	result := *target
+	result.Count = 1
+	cost := 0
+	used := 1
+
+	if repair := target.RepairableWith(); target.MaxDamage() > 0 && repair != "" && reg.matchItems(repair, sacrifice.Item.Name) {
+		// Repair materials restore a quarter of the durability each
+		damage := target.Damage()
+		if damage == 0 {
+			return AnvilResult{}, false
+		}
+		quarter := max(target.MaxDamage()/4, 1)
+		used = 0
+		for damage > 0 && used < sacrifice.Count {
+			damage = max(damage-quarter, 0)
+			used++
+			cost++
+		}
+		result.SetComponent(ComponentDamage, damage)
+	} else {
+		book := sacrifice.IsEnchantedBook() && len(sacrifice.StoredEnchantments()) > 0
+		if !book && sacrifice.Item.Name != target.Item.Name {
+			return AnvilResult{}, false
+		}
+		if !book && target.MaxDamage() > 0 && target.Damage() > 0 {
+			// Two of the same item: combined durability plus a 12% bonus
+			remaining := target.Durability() + sacrifice.Durability() + target.MaxDamage()*12/100
+			result.SetComponent(ComponentDamage, max(target.MaxDamage()-remaining, 0))
+			cost += 2
+		}
+
+		enchantments := maps.Clone(result.AllEnchantments())
+		if enchantments == nil {
+			enchantments = Enchantments{}
+		}
+		applied, rejected := false, false
+		names := make([]string, 0, len(sacrifice.AllEnchantments()))
+		for name := range sacrifice.AllEnchantments() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			info, ok := reg.Enchantments[name]
+			if !ok {
+				continue
+			}
+			level := sacrifice.AllEnchantments()[name]
+			if current := enchantments[name]; current == level {
+				level++
+			} else {
+				level = max(level, current)
+			}
+
+			allowed := creative || target.IsEnchantedBook() || reg.Supports(info, target.Item.Name)
+			for other := range enchantments {
+				if other != name && !reg.Compatible(name, other) {
+					allowed = false
+					cost++
+				}
+			}
+			if !allowed {
+				rejected = true
+				continue
+			}
+			applied = true
+			enchantments[name] = min(level, info.MaxLevel)
+			anvilCost := info.AnvilCost
+			if book {
+				anvilCost = max(1, anvilCost/2)
+			}
+			cost += anvilCost * enchantments[name]
+			if target.Count > 1 {
+				cost = MaxAnvilCost
+			}
+		}
+		if rejected && !applied {
+			return AnvilResult{}, false
+		}
+		result.SetEnchantments(enchantments)
+	}
+
+	if cost <= 0 {
+		return AnvilResult{}, false
+	}
+	cost += target.RepairCost() + sacrifice.RepairCost()
+	result.SetComponent(ComponentRepairCost, NextRepairCost(max(target.RepairCost(), sacrifice.RepairCost())))
+	return AnvilResult{
+		Result:       result,
+		Cost:         cost,
+		MaterialUsed: used,
+		TooExpensive: cost >= MaxAnvilCost && !creative,
+	}, true
+}
+
+// NextRepairCost returns the prior work penalty after one more anvil use
+func NextRepairCost(cost int) int {
+	return cost*2 + 1
+}
+
+// EnchantingCosts returns the level costs of the three enchanting table options for an
+// item with the given enchantability (all zero if it cannot be enchanted)
+func EnchantingCosts(rng *rand.Rand, bookshelves, enchantability int) [3]int {
+	var costs [3]int
+	if enchantability <= 0 {
+		return costs
+	}
+	bookshelves = min(bookshelves, 15)
+	for slot := range costs {
+		base := rng.Intn(8) + 1 + bookshelves>>1 + rng.Intn(bookshelves+1)
+		switch slot {
+		case 0:
+			costs[slot] = max(base/3, 1)
+		case 1:
+			costs[slot] = base*2/3 + 1
+		default:
+			costs[slot] = max(base, bookshelves*2)
+		}
+	}
+	return costs
+}
+
+// EnchantmentOption is an enchantment and level the enchanting table can roll
+type EnchantmentOption struct {
+	Enchantment *EnchantmentInfo
+	Level       int
+}
+
+// Available returns the enchantments the enchanting table offers an item at an
+// enchanting power, each at the highest level whose cost range contains it
+func (reg *EnchantmentRegistry) Available(item string, power int) []EnchantmentOption {
+	var options []EnchantmentOption
+	book := item == "minecraft:book"
+	for _, e := range reg.sorted() {
+		if e.Treasure || e.Curse {
+			continue
+		}
+		items := e.PrimaryItems
+		if items == "" {
+			items = e.SupportedItems
+		}
+		if !book && !reg.matchItems(items, item) {
+			continue
+		}
+		for level := e.MaxLevel; level >= 1; level-- {
+			if power >= e.MinCost.At(level) && power <= e.MaxCost.At(level) {
+				options = append(options, EnchantmentOption{Enchantment: e, Level: level})
+				break
+			}
+		}
+	}
+	return options
+}
+
+// Roll picks the enchantments the enchanting table applies for a level cost, like vanilla
+func (reg *EnchantmentRegistry) Roll(rng *rand.Rand, stack *ItemStack, cost int) []EnchantmentOption {
+	enchantability := stack.Enchantability()
+	if stack.Item.Name == "minecraft:book" {
+		enchantability = 1
+	}
+	if enchantability <= 0 {
+		return nil
+	}
+	power := cost + 1 + rng.Intn(enchantability/4+1) + rng.Intn(enchantability/4+1)
+	spread := (rng.Float64() + rng.Float64() - 1) * 0.15
+	power = max(int(math.Round(float64(power)+float64(power)*spread)), 1)
+
+	options := reg.Available(stack.Item.Name, power)
+	var picked []EnchantmentOption
+	for len(options) > 0 {
+		choice := weightedPick(rng, options)
+		picked = append(picked, choice)
+		if rng.Intn(50) > power {
+			break
+		}
+		var compatible []EnchantmentOption
+		for _, option := range options {
+			if reg.Compatible(option.Enchantment.Name, choice.Enchantment.Name) {
+				compatible = append(compatible, option)
+			}
+		}
+		options = compatible
+		power /= 2
+	}
+	return picked
+}
+
+func weightedPick(rng *rand.Rand, options []EnchantmentOption) EnchantmentOption {
+	total := 0
+	for _, option := range options {
+		total += option.Enchantment.Weight
+	}
+	n := rng.Intn(max(total, 1))
+	for _, option := range options {
+		n -= option.Enchantment.Weight
+		if n < 0 {
+			return option
+		}
+	}
+	return options[len(options)-1]
+}
+
+// DamageKind selects which protection enchantments reduce a hit
+type DamageKind string
+
+const (
+	DamageGeneric    DamageKind = "generic"
+	DamageFire       DamageKind = "fire"
+	DamageExplosion  DamageKind = "explosion"
+	DamageProjectile DamageKind = "projectile"
+	DamageFall       DamageKind = "fall"
+	DamageBypass     DamageKind = "bypass" // Void, starvation, ... (no protection)
+)
+
+// ProtectionEPF sums the enchantment protection factor of worn armor against a damage
+// kind; pass it to entity.DamageAfterProtection (which caps it at 20)
+func ProtectionEPF(armor []ItemStack, kind DamageKind) int {
+	if kind == DamageBypass {
+		return 0
+	}
+	epf := 0
+	for i := range armor {
+		if armor[i].IsEmpty() {
+			continue
+		}
+		enchantments := armor[i].Enchantments()
+		epf += enchantments[EnchantProtection]
+		switch kind {
+		case DamageFire:
+			epf += 2 * enchantments[EnchantFireProtection]
+		case DamageExplosion:
+			epf += 2 * enchantments[EnchantBlastProtection]
+		case DamageProjectile:
+			epf += 2 * enchantments[EnchantProjectileProtection]
+		case DamageFall:
+			epf += 3 * enchantments[EnchantFeatherFalling]
+		}
+	}
+	return epf
+}
+
+// AttackTarget lists the groups of an attacked entity that weapon enchantments care about
+// (entity type tags sensitive_to_smite, sensitive_to_bane_of_arthropods, sensitive_to_impaling)
+type AttackTarget struct {
+	Undead    bool
+	Arthropod bool
+	Aquatic   bool
+}
+
+// AttackDamageBonus returns the extra melee damage from weapon enchantments
+func AttackDamageBonus(weapon *ItemStack, target AttackTarget) float64 {
+	if weapon == nil || weapon.IsEmpty() {
+		return 0
+	}
+	enchantments := weapon.Enchantments()
+	bonus := 0.0
+	if level := enchantments[EnchantSharpness]; level > 0 {
+		bonus += 0.5*float64(level) + 0.5
+	}
+	if target.Undead {
+		bonus += 2.5 * float64(enchantments[EnchantSmite])
+	}
+	if target.Arthropod {
+		bonus += 2.5 * float64(enchantments[EnchantBaneOfArthropods])
+	}
+	if target.Aquatic {
+		bonus += 2.5 * float64(enchantments[EnchantImpaling])
+	}
+	return bonus
+}
+
+// DurabilityLossChance returns the chance that a use costs durability with Unbreaking
+// (armor ignores only 60% of the usual reduction)
+func DurabilityLossChance(stack *ItemStack) float64 {
+	level := stack.Enchantments()[EnchantUnbreaking]
+	if level <= 0 {
+		return 1
+	}
+	switch stack.EquipmentSlot() {
+	case EquipHead, EquipChest, EquipLegs, EquipFeet, EquipBody:
+		return 0.6 + 0.4/float64(level+1)
+	}
+	return 1 / float64(level+1)
+}
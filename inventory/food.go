@@ -0,0 +1,95 @@
+package inventory
+
+import "math"
+
+// MaxFoodLevel is a full hunger bar
+const MaxFoodLevel = 20
+
+// AvoidFoods lists foods BestFood never picks: they have harmful effects, or are
+// worth more than their food value
+var AvoidFoods = map[string]bool{
+	"minecraft:rotten_flesh":           true,
+	"minecraft:spider_eye":             true,
+	"minecraft:poisonous_potato":       true,
+	"minecraft:pufferfish":             true,
+	"minecraft:chicken":                true,
+	"minecraft:suspicious_stew":        true,
+	"minecraft:chorus_fruit":           true,
+	"minecraft:golden_apple":           true,
+	"minecraft:enchanted_golden_apple": true,
+}
+
+// FoodChoice is the item picked to eat
+type FoodChoice struct {
+	Slot       int     // Inventory slot of the food
+	Nutrition  int     // Food points gained
+	Saturation float64 // Saturation gained
+	Overflow   int     // Food points lost to the full hunger bar
+	Wasted     float64 // Saturation lost to the cap (the food level after eating)
+}
+
+// foodGain computes what eating a food gains at the given food and saturation levels
+func foodGain(f *Food, food int, saturation float64) FoodChoice {
+	nutrition := min(f.Nutrition, MaxFoodLevel-food)
+	gained := min(saturation+f.Saturation, float64(food+nutrition)) - saturation
+	return FoodChoice{
+		Nutrition:  nutrition,
+		Saturation: gained,
+		Overflow:   f.Nutrition - nutrition,
+		Wasted:     max(f.Saturation-gained, 0),
+	}
+}
+
+// BestFood picks the food that restores the most food and saturation without going
+// over the hunger bar, wasting the least saturation on ties. If every food overflows
+// the bar, the one overflowing least is picked. The held item and the hotbar are
+// preferred, then the main inventory and the offhand. Foods in AvoidFoods are
+// skipped, and it returns false if the bar is full or there is nothing to eat.
+func (inv *Inventory) BestFood(food int, saturation float64) (FoodChoice, bool) {
+	if food >= MaxFoodLevel {
+		return FoodChoice{}, false
+	}
+
+	slots := []int{SlotHotbarStart + inv.HeldSlot}
+	for i := SlotHotbarStart; i < SlotHotbarStart+9; i++ {
+		slots = append(slots, i)
+	}
+	for i := SlotMainStart; i < SlotHotbarStart; i++ {
+		slots = append(slots, i)
+	}
+	slots = append(slots, SlotOffhand)
+
+	best, found := FoodChoice{Slot: -1}, false
+	for _, slot := range slots {
+		if slot < 0 || slot >= inv.Size {
+			continue
+		}
+		stack := &inv.Slots[slot]
+		if stack.IsEmpty() || AvoidFoods[stack.Item.Name] {
+			continue
+		}
+		f := stack.Food()
+		if f == nil {
+			continue
+		}
+		choice := foodGain(f, food, saturation)
+		choice.Slot = slot
+		if !found || betterFood(choice, best) {
+			best, found = choice, true
+		}
+	}
+	return best, found
+}
+
+// betterFood reports whether a strictly beats b
+func betterFood(a, b FoodChoice) bool {
+	const epsilon = 1e-9
+	if a.Overflow != b.Overflow {
+		return a.Overflow < b.Overflow
+	}
+	gainA, gainB := float64(a.Nutrition)+a.Saturation, float64(b.Nutrition)+b.Saturation
+	if math.Abs(gainA-gainB) > epsilon {
+		return gainA > gainB
+	}
+	return a.Wasted < b.Wasted-epsilon
+}
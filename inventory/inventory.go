@@ -7,6 +7,20 @@ var (
 	ErrInvalidSlot    = errors.New("invalid slot")
 )
 
+// Player inventory slot indices (player window numbering)
+const (
+	SlotCraftResult     = 0  // Crafting output
+	SlotCraftGrid       = 1  // First of 4 crafting grid slots
+	SlotArmorHead       = 5  // Helmet
+	SlotArmorChest      = 6  // Chestplate
+	SlotArmorLegs       = 7  // Leggings
+	SlotArmorFeet       = 8  // Boots
+	SlotMainStart       = 9  // First of 27 main inventory slots
+	SlotHotbarStart     = 36 // First of 9 hotbar slots
+	SlotOffhand         = 45 // Offhand
+	PlayerInventorySize = 46
+)
+
 // Inventory represents a player's inventory
 type Inventory struct {
 	Slots    []ItemStack // All inventory slots (typically 46: 9 hotbar + 27 main + 4 armor + 1 offhand + 5 crafting)
@@ -23,6 +37,15 @@ func NewInventory(size int) *Inventory {
 	}
 }
 
+// HeldItem returns the stack in the selected hotbar slot (nil if the inventory has no hotbar)
+func (inv *Inventory) HeldItem() *ItemStack {
+	slot := SlotHotbarStart + inv.HeldSlot
+	if slot < 0 || slot >= inv.Size {
+		return nil
+	}
+	return &inv.Slots[slot]
+}
+
 // GetSlot gets an item stack from a slot
 func (inv *Inventory) GetSlot(slot int) (*ItemStack, error) {
 	if slot < 0 || slot >= inv.Size {
@@ -2,17 +2,18 @@ package inventory
 
 // Item represents a Minecraft item type
 type Item struct {
-	ID          int    // Item ID
-	Name        string // Item name (e.g., "minecraft:diamond")
-	DisplayName string // Display name (e.g., "Diamond")
-	StackSize   int    // Maximum stack size
+	ID          int        // Item ID
+	Name        string     // Item name (e.g., "minecraft:diamond")
+	DisplayName string     // Display name (e.g., "Diamond")
+	StackSize   int        // Maximum stack size
+	Prototype   Components // Default components of the item (nil if unknown)
 }
 
 // ItemStack represents a stack of items in an inventory slot
 type ItemStack struct {
-	Item  Item                   // Item type
-	Count int                    // Number of items in stack
-	NBT   map[string]interface{} // NBT data (simplified)
+	Item       Item           // Item type
+	Count      int            // Number of items in stack
+	Components ComponentPatch // Component changes relative to the item's prototype
 }
 
 // IsEmpty returns true if this stack is empty
@@ -20,19 +21,30 @@ func (s *ItemStack) IsEmpty() bool {
 	return s.Count <= 0 || s.Item.ID == 0
 }
 
+// MaxStackSize returns the maximum stack size of the item (64 if unknown)
+func (s *ItemStack) MaxStackSize() int {
+	if size, ok := component[int](s, ComponentMaxStackSize); ok && size > 0 {
+		return size
+	}
+	if s.Item.StackSize > 0 {
+		return s.Item.StackSize
+	}
+	return 64
+}
+
 // CanStack checks if this stack can be combined with another
 func (s *ItemStack) CanStack(other *ItemStack) bool {
 	if s.IsEmpty() || other.IsEmpty() {
 		return false
 	}
-	// Simplified: check if same item (NBT comparison would be more complex)
-	return s.Item.ID == other.Item.ID
+	return s.Item.ID == other.Item.ID && s.SameComponents(other)
 }
 
 // ItemInfo contains detailed information about an item type
 type ItemInfo struct {
-	ID         int    // Item ID
-	Name       string // Item name
-	StackSize  int    // Maximum stack size
-	Durability int    // Maximum durability (0 if not applicable)
+	ID         int        // Item ID
+	Name       string     // Item name
+	StackSize  int        // Maximum stack size
+	Durability int        // Maximum durability (0 if not applicable)
+	Prototype  Components // Default components
 }
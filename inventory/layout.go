@@ -0,0 +1,366 @@
+package inventory
+
+// Slot range names used by the layouts
+const (
+	RangeStorage    = "storage"
+	RangeResult     = "result"
+	RangeGrid       = "grid"
+	RangeInput      = "input"
+	RangeAddition   = "addition"
+	RangeFuel       = "fuel"
+	RangeBottles    = "bottles"
+	RangeIngredient = "ingredient"
+	RangePayment    = "payment"
+	RangeLapis      = "lapis"
+	RangeTemplate   = "template"
+	RangeBase       = "base"
+	RangeBanner     = "banner"
+	RangeDye        = "dye"
+	RangePattern    = "pattern"
+	RangeMap        = "map"
+	RangeHead       = "head"
+	RangeChest      = "chest"
+	RangeLegs       = "legs"
+	RangeFeet       = "feet"
+	RangeOffhand    = "offhand"
+	RangeMain       = "main"
+	RangeHotbar     = "hotbar"
+)
+
+// SlotRange is a named run of window slots with the same placement rules
+type SlotRange struct {
+	Name     string   // Range name (e.g. RangeInput)
+	Start    int      // First window slot
+	Count    int      // Number of slots
+	Rule     SlotRule // Items that may be placed (RuleAny for all)
+	MaxStack int      // Per-slot item limit (0 for the item's max stack size)
+	Output   bool     // Result slot: items can be taken but not placed
+	Partial  bool     // Output slot that can be partly taken (furnace results); others give the whole stack
+	Locked   bool     // Display only: the slot cannot be clicked at all
+}
+
+// End returns the slot after the last slot of the range
+func (r SlotRange) End() int {
+	return r.Start + r.Count
+}
+
+// Contains checks if a window slot is in the range
+func (r SlotRange) Contains(slot int) bool {
+	return slot >= r.Start && slot < r.End()
+}
+
+// QuickMoveRoute is a shift-click destination for stacks from the player inventory
+type QuickMoveRoute struct {
+	Ranges  []string // Destination ranges (adjacent ranges are filled as one)
+	Rule    SlotRule // Items taking this route (RuleAny for all)
+	IfEmpty bool     // Only taken while the first destination slot is empty
+}
+
+// Layout describes the slots of a window type. Window slots are numbered like
+// the protocol: container slots, then 27 main inventory and 9 hotbar slots,
+// then any trailing container slots (the player offhand, the crafter result).
+type Layout struct {
+	Type     WindowType       // Menu type
+	Size     int              // Container slots before the player inventory
+	Ranges   []SlotRange      // All ranges in window slot order
+	Routes   []QuickMoveRoute // Shift-click destinations for player stacks, tried in order
+	Storage  bool             // Plain storage: shift-click only moves between container and player
+	Fallback bool             // Swap between main and hotbar when the chosen route moves nothing
+}
+
+// SlotCount returns the total number of window slots
+func (l *Layout) SlotCount() int {
+	last := l.Ranges[len(l.Ranges)-1]
+	return last.End()
+}
+
+// ContainerSlots returns the number of slots held by the window itself (not the player)
+func (l *Layout) ContainerSlots() int {
+	return l.SlotCount() - 36
+}
+
+// Range returns the range with the given name
+func (l *Layout) Range(name string) (SlotRange, bool) {
+	for _, r := range l.Ranges {
+		if r.Name == name {
+			return r, true
+		}
+	}
+	return SlotRange{}, false
+}
+
+// RangeAt returns the range containing a window slot
+func (l *Layout) RangeAt(slot int) (SlotRange, bool) {
+	for _, r := range l.Ranges {
+		if r.Contains(slot) {
+			return r, true
+		}
+	}
+	return SlotRange{}, false
+}
+
+// IsPlayerSlot checks if a window slot belongs to the main inventory or hotbar
+func (l *Layout) IsPlayerSlot(slot int) bool {
+	return slot >= l.Size && slot < l.Size+36
+}
+
+// PlayerSlot maps a player inventory slot (player window numbering) to a window slot
+func (l *Layout) PlayerSlot(index int) (int, bool) {
+	if l.Type == WindowTypePlayer {
+		return index, index >= 0 && index < PlayerInventorySize
+	}
+	if index < SlotMainStart || index >= SlotOffhand {
+		return 0, false
+	}
+	return l.Size + index - SlotMainStart, true
+}
+
+// InventorySlot maps a window slot to a player inventory slot (player window numbering)
+func (l *Layout) InventorySlot(slot int) (int, bool) {
+	if l.Type == WindowTypePlayer {
+		return slot, slot >= 0 && slot < PlayerInventorySize
+	}
+	if !l.IsPlayerSlot(slot) {
+		return 0, false
+	}
+	return SlotMainStart + slot - l.Size, true
+}
+
+// routeRange returns the slots covered by a route
+func (l *Layout) routeRange(route QuickMoveRoute) (slotRange, bool) {
+	target := slotRange{Start: -1}
+	for _, name := range route.Ranges {
+		r, ok := l.Range(name)
+		if !ok {
+			return slotRange{}, false
+		}
+		if target.Start < 0 || r.Start < target.Start {
+			target.Start = r.Start
+		}
+		target.End = max(target.End, r.End())
+	}
+	return target, target.Start >= 0
+}
+
+// newLayout numbers the container ranges, the player inventory and the trailing ranges in order
+func newLayout(t WindowType, container []SlotRange, trailing ...SlotRange) *Layout {
+	l := &Layout{Type: t}
+	next := 0
+	add := func(r SlotRange) {
+		r.Start = next
+		next += r.Count
+		l.Ranges = append(l.Ranges, r)
+	}
+	for _, r := range container {
+		add(r)
+	}
+	l.Size = next
+	add(SlotRange{Name: RangeMain, Count: 27})
+	add(SlotRange{Name: RangeHotbar, Count: 9})
+	for _, r := range trailing {
+		add(r)
+	}
+	return l
+}
+
+func storageLayout(t WindowType, size int, rule SlotRule) *Layout {
+	l := newLayout(t, []SlotRange{{Name: RangeStorage, Count: size, Rule: rule}})
+	l.Storage = true
+	return l
+}
+
+func route(rule SlotRule, ranges ...string) QuickMoveRoute {
+	return QuickMoveRoute{Ranges: ranges, Rule: rule}
+}
+
+// PlayerLayout is the layout of the player inventory window
+var PlayerLayout = func() *Layout {
+	l := newLayout(WindowTypePlayer, []SlotRange{
+		{Name: RangeResult, Count: 1, Output: true},
+		{Name: RangeGrid, Count: 4},
+		{Name: RangeHead, Count: 1, Rule: RuleHead, MaxStack: 1},
+		{Name: RangeChest, Count: 1, Rule: RuleChest, MaxStack: 1},
+		{Name: RangeLegs, Count: 1, Rule: RuleLegs, MaxStack: 1},
+		{Name: RangeFeet, Count: 1, Rule: RuleFeet, MaxStack: 1},
+	}, SlotRange{Name: RangeOffhand, Count: 1})
+	l.Routes = []QuickMoveRoute{
+		{Ranges: []string{RangeHead}, Rule: RuleHead, IfEmpty: true},
+		{Ranges: []string{RangeChest}, Rule: RuleChest, IfEmpty: true},
+		{Ranges: []string{RangeLegs}, Rule: RuleLegs, IfEmpty: true},
+		{Ranges: []string{RangeFeet}, Rule: RuleFeet, IfEmpty: true},
+		{Ranges: []string{RangeOffhand}, Rule: RuleOffhand, IfEmpty: true},
+	}
+	return l
+}()
+
+func furnaceLayout(t WindowType, input SlotRule) *Layout {
+	l := newLayout(t, []SlotRange{
+		{Name: RangeInput, Count: 1, Rule: input},
+		{Name: RangeFuel, Count: 1, Rule: RuleFuel},
+		{Name: RangeResult, Count: 1, Output: true, Partial: true},
+	})
+	l.Routes = []QuickMoveRoute{route(input, RangeInput), route(RuleFuel, RangeFuel)}
+	return l
+}
+
+// LayoutFor returns the layout of a window type with the given number of
+// container slots. Unknown types, and windows whose size does not match the
+// type, are treated as plain storage.
+func LayoutFor(t WindowType, size int) *Layout {
+	l := knownLayout(t)
+	if l == nil || l.ContainerSlots() != size {
+		return storageLayout(t, size, RuleAny)
+	}
+	return l
+}
+
+func knownLayout(t WindowType) *Layout {
+	switch t {
+	case WindowTypePlayer:
+		return PlayerLayout
+	case WindowTypeGeneric9x1:
+		return storageLayout(t, 9, RuleAny)
+	case WindowTypeGeneric9x2:
+		return storageLayout(t, 18, RuleAny)
+	case WindowTypeGeneric9x3, WindowTypeChest:
+		return storageLayout(t, 27, RuleAny)
+	case WindowTypeGeneric9x4:
+		return storageLayout(t, 36, RuleAny)
+	case WindowTypeGeneric9x5:
+		return storageLayout(t, 45, RuleAny)
+	case WindowTypeGeneric9x6:
+		return storageLayout(t, 54, RuleAny)
+	case WindowTypeGeneric3x3, WindowTypeDispenser:
+		return storageLayout(t, 9, RuleAny)
+	case WindowTypeHopper:
+		return storageLayout(t, 5, RuleAny)
+	case WindowTypeShulkerBox:
+		return storageLayout(t, 27, RuleShulkerStorage)
+
+	case WindowTypeCrafter3x3:
+		l := newLayout(t, []SlotRange{{Name: RangeGrid, Count: 9}},
+			SlotRange{Name: RangeResult, Count: 1, Output: true, Locked: true})
+		l.Storage = true
+		return l
+
+	case WindowTypeCrafting, WindowTypeCraftingTable:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeResult, Count: 1, Output: true},
+			{Name: RangeGrid, Count: 9},
+		})
+		l.Routes = []QuickMoveRoute{route(RuleAny, RangeGrid)}
+		l.Fallback = true
+		return l
+
+	case WindowTypeFurnace:
+		return furnaceLayout(t, RuleSmeltable)
+	case WindowTypeBlastFurnace:
+		return furnaceLayout(t, RuleBlastable)
+	case WindowTypeSmoker:
+		return furnaceLayout(t, RuleSmokable)
+
+	case WindowTypeBrewingStand:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeBottles, Count: 3, Rule: RuleBrewingBottle, MaxStack: 1},
+			{Name: RangeIngredient, Count: 1, Rule: RuleBrewingIngredient},
+			{Name: RangeFuel, Count: 1, Rule: RuleBrewingFuel},
+		})
+		l.Routes = []QuickMoveRoute{
+			route(RuleBrewingFuel, RangeFuel),
+			route(RuleBrewingIngredient, RangeIngredient),
+			route(RuleBrewingBottle, RangeBottles),
+		}
+		return l
+
+	case WindowTypeAnvil:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeInput, Count: 1},
+			{Name: RangeAddition, Count: 1},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+		l.Routes = []QuickMoveRoute{route(RuleAny, RangeInput, RangeAddition)}
+		return l
+
+	case WindowTypeSmithing:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeTemplate, Count: 1, Rule: RuleSmithingTemplate},
+			{Name: RangeBase, Count: 1, Rule: RuleSmithingBase},
+			{Name: RangeAddition, Count: 1, Rule: RuleSmithingAddition},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+		l.Routes = []QuickMoveRoute{
+			route(RuleSmithingTemplate, RangeTemplate),
+			route(RuleSmithingBase, RangeBase),
+			route(RuleSmithingAddition, RangeAddition),
+		}
+		return l
+
+	case WindowTypeGrindstone:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeInput, Count: 1, Rule: RuleGrindable},
+			{Name: RangeAddition, Count: 1, Rule: RuleGrindable},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+		l.Routes = []QuickMoveRoute{route(RuleGrindable, RangeInput, RangeAddition)}
+		return l
+
+	case WindowTypeStonecutter:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeInput, Count: 1},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+		l.Routes = []QuickMoveRoute{route(RuleStonecuttable, RangeInput)}
+		return l
+
+	case WindowTypeLoom:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeBanner, Count: 1, Rule: RuleBanner},
+			{Name: RangeDye, Count: 1, Rule: RuleDye},
+			{Name: RangePattern, Count: 1, Rule: RuleBannerPattern},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+		l.Routes = []QuickMoveRoute{
+			route(RuleBanner, RangeBanner),
+			route(RuleDye, RangeDye),
+			route(RuleBannerPattern, RangePattern),
+		}
+		return l
+
+	case WindowTypeCartographyTable:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeMap, Count: 1, Rule: RuleFilledMap},
+			{Name: RangeAddition, Count: 1, Rule: RuleMapAddition},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+		l.Routes = []QuickMoveRoute{
+			route(RuleFilledMap, RangeMap),
+			route(RuleMapAddition, RangeAddition),
+		}
+		return l
+
+	case WindowTypeEnchantment, WindowTypeEnchanting:
+		l := newLayout(t, []SlotRange{
+			{Name: RangeInput, Count: 1, MaxStack: 1},
+			{Name: RangeLapis, Count: 1, Rule: RuleLapis},
+		})
+		l.Routes = []QuickMoveRoute{
+			route(RuleLapis, RangeLapis),
+			{Ranges: []string{RangeInput}, IfEmpty: true},
+		}
+		return l
+
+	case WindowTypeBeacon:
+		l := newLayout(t, []SlotRange{
+			{Name: RangePayment, Count: 1, Rule: RuleBeaconPayment, MaxStack: 1},
+		})
+		l.Routes = []QuickMoveRoute{{Ranges: []string{RangePayment}, Rule: RuleBeaconPayment, IfEmpty: true}}
+		return l
+
+	case WindowTypeMerchant:
+		return newLayout(t, []SlotRange{
+			{Name: RangePayment, Count: 2},
+			{Name: RangeResult, Count: 1, Output: true},
+		})
+	}
+	return nil
+}
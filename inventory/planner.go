@@ -0,0 +1,230 @@
+package inventory
+
+import (
+	"slices"
+	"strings"
+)
+
+// CraftOption is a recipe that can be crafted from the available items
+type CraftOption struct {
+	Recipe *Recipe // Shaped or shapeless recipe
+	Times  int     // Number of times it can be crafted
+}
+
+// Craftable returns the crafting recipes that can be made from the items and how
+// many times each, limited to recipes fitting a size x size grid (2 or 3)
+func (b *RecipeBook) Craftable(items []ItemStack, size int) []CraftOption {
+	counts := countItems(items)
+	var options []CraftOption
+	for _, r := range b.Recipes {
+		if !r.FitsGrid(size) {
+			continue
+		}
+		if times := b.MaxCrafts(r, counts); times > 0 {
+			options = append(options, CraftOption{Recipe: r, Times: times})
+		}
+	}
+	return options
+}
+
+// MaxCrafts returns how many times a recipe can be crafted from item counts.
+// Tag and alternative ingredients take the item with the most remaining.
+func (b *RecipeBook) MaxCrafts(r *Recipe, counts map[string]int) int {
+	inputs := r.Inputs()
+	if len(inputs) == 0 {
+		return 0
+	}
+
+	candidates := make([][]string, len(inputs))
+	for i, ing := range inputs {
+		for item := range counts {
+			if ing.Matches(item, b.Tags) {
+				candidates[i] = append(candidates[i], item)
+			}
+		}
+		if len(candidates[i]) == 0 {
+			return 0
+		}
+		slices.Sort(candidates[i])
+	}
+
+	remaining := make(map[string]int, len(counts))
+	for item, count := range counts {
+		remaining[item] = count
+	}
+	times := 0
+	for {
+		for i := range inputs {
+			best := ""
+			for _, item := range candidates[i] {
+				if remaining[item] > 0 && (best == "" || remaining[item] > remaining[best]) {
+					best = item
+				}
+			}
+			if best == "" {
+				return times
+			}
+			remaining[best]--
+		}
+		times++
+	}
+}
+
+// MaterialNode is one step of a crafting tree
+type MaterialNode struct {
+	Item   string          // Item name, or a tag ("#...") that could not be resolved
+	Count  int             // Items needed
+	Recipe *Recipe         // Recipe producing the item (nil for raw materials)
+	Crafts int             // Times the recipe is applied
+	Inputs []*MaterialNode // Materials consumed by the crafts
+}
+
+// ExpandOptions controls how a target item is broken down
+type ExpandOptions struct {
+	Types    []RecipeType           // Recipe types to follow (default shaped and shapeless crafting)
+	Raw      func(item string) bool // Items never expanded (optional)
+	MaxDepth int                    // Maximum tree depth (default 16)
+}
+
+// Expand breaks a target item down into the tree of recipes and raw materials
+// needed to make count of it. Recipes that lead back to an item already on the
+// path are skipped, and storage conversions (ingots, nuggets and blocks) are only
+// followed for the target itself. The first remaining recipe is used, preferring
+// the one whose ID matches the item.
+func (b *RecipeBook) Expand(item string, count int, opts ExpandOptions) *MaterialNode {
+	if len(opts.Types) == 0 {
+		opts.Types = []RecipeType{RecipeShaped, RecipeShapeless}
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = 16
+	}
+	return b.expand(item, count, opts, map[string]bool{})
+}
+
+func (b *RecipeBook) expand(item string, count int, opts ExpandOptions, path map[string]bool) *MaterialNode {
+	node := &MaterialNode{Item: item, Count: count}
+	if strings.HasPrefix(item, "#") || len(path) >= opts.MaxDepth || (opts.Raw != nil && opts.Raw(item)) {
+		return node
+	}
+
+	path[item] = true
+	defer delete(path, item)
+
+	for _, r := range b.recipesFor(item, opts.Types) {
+		inputs, ok := b.resolveInputs(r, path)
+		if !ok || len(path) > 1 && b.isConversion(r, inputs) {
+			continue
+		}
+		crafts := (count + r.Result.Count - 1) / r.Result.Count
+		node.Recipe, node.Crafts = r, crafts
+		for _, input := range inputs {
+			node.Inputs = append(node.Inputs, b.expand(input.item, input.count*crafts, opts, path))
+		}
+		return node
+	}
+	return node
+}
+
+type materialCount struct {
+	item  string
+	count int
+}
+
+// resolveInputs groups a recipe's inputs by item, picking the first item of each
+// ingredient; it fails if an input is already being expanded
+func (b *RecipeBook) resolveInputs(r *Recipe, path map[string]bool) ([]materialCount, bool) {
+	var inputs []materialCount
+	for _, ing := range r.Inputs() {
+		if len(ing) == 0 {
+			continue
+		}
+		item := ing[0]
+		if strings.HasPrefix(item, "#") {
+			if items := ing.Items(b.Tags); len(items) > 0 {
+				item = items[0]
+			}
+		}
+		if path[item] {
+			return nil, false
+		}
+		found := false
+		for i := range inputs {
+			if inputs[i].item == item {
+				inputs[i].count++
+				found = true
+				break
+			}
+		}
+		if !found {
+			inputs = append(inputs, materialCount{item, 1})
+		}
+	}
+	return inputs, true
+}
+
+// isConversion reports whether a recipe turns a single item into its result and
+// another recipe turns the result back into that item (iron_ingot <-> iron_block)
+func (b *RecipeBook) isConversion(r *Recipe, inputs []materialCount) bool {
+	if len(inputs) != 1 {
+		return false
+	}
+	for _, back := range b.ForResult(inputs[0].item) {
+		if back.Type != RecipeShaped && back.Type != RecipeShapeless {
+			continue
+		}
+		reverse := true
+		for _, ing := range back.Inputs() {
+			if !ing.Matches(r.Result.Item, b.Tags) {
+				reverse = false
+				break
+			}
+		}
+		if reverse {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *RecipeBook) recipesFor(item string, types []RecipeType) []*Recipe {
+	var preferred, others []*Recipe
+	for _, r := range b.ForResult(item) {
+		if !slices.Contains(types, r.Type) {
+			continue
+		}
+		if r.ID == item {
+			preferred = append(preferred, r)
+		} else {
+			others = append(others, r)
+		}
+	}
+	return append(preferred, others...)
+}
+
+// RawMaterials sums the raw materials at the leaves of the tree
+func (n *MaterialNode) RawMaterials() map[string]int {
+	totals := make(map[string]int)
+	var walk func(*MaterialNode)
+	walk = func(node *MaterialNode) {
+		if node.Recipe == nil {
+			totals[node.Item] += node.Count
+			return
+		}
+		for _, input := range node.Inputs {
+			walk(input)
+		}
+	}
+	walk(n)
+	return totals
+}
+
+// countItems sums stack counts by item name
+func countItems(items []ItemStack) map[string]int {
+	counts := make(map[string]int)
+	for i := range items {
+		if !items[i].IsEmpty() {
+			counts[items[i].Item.Name] += items[i].Count
+		}
+	}
+	return counts
+}
@@ -0,0 +1,323 @@
+package inventory
+
+import (
+	"sort"
+	"strings"
+)
+
+// RecipeType is the recipe serializer name
+type RecipeType string
+
+const (
+	RecipeShaped            RecipeType = "minecraft:crafting_shaped"
+	RecipeShapeless         RecipeType = "minecraft:crafting_shapeless"
+	RecipeSmelting          RecipeType = "minecraft:smelting"
+	RecipeBlasting          RecipeType = "minecraft:blasting"
+	RecipeSmoking           RecipeType = "minecraft:smoking"
+	RecipeCampfire          RecipeType = "minecraft:campfire_cooking"
+	RecipeStonecutting      RecipeType = "minecraft:stonecutting"
+	RecipeSmithingTransform RecipeType = "minecraft:smithing_transform"
+	RecipeSmithingTrim      RecipeType = "minecraft:smithing_trim"
+)
+
+// IsCrafting returns true for crafting grid recipes (including special ones)
+func (t RecipeType) IsCrafting() bool {
+	return strings.HasPrefix(string(t), "minecraft:crafting_")
+}
+
+// IsCooking returns true for furnace, blast furnace, smoker and campfire recipes
+func (t RecipeType) IsCooking() bool {
+	return t == RecipeSmelting || t == RecipeBlasting || t == RecipeSmoking || t == RecipeCampfire
+}
+
+// IsSpecial returns true for crafting recipes implemented in code (map cloning, armor dye, ...)
+func (t RecipeType) IsSpecial() bool {
+	return t.IsCrafting() && t != RecipeShaped && t != RecipeShapeless
+}
+
+// ItemTags resolves item tags used by tag ingredients. Tag names have no "#" prefix.
+type ItemTags interface {
+	HasTag(item, tag string) bool // Checks if an item is in a tag
+	TagItems(tag string) []string // Lists the items in a tag
+}
+
+// Ingredient matches any of its entries: item names or tags ("#minecraft:planks")
+type Ingredient []string
+
+// Matches checks if an item matches the ingredient
+func (ing Ingredient) Matches(item string, tags ItemTags) bool {
+	for _, entry := range ing {
+		if tag, ok := strings.CutPrefix(entry, "#"); ok {
+			if tags != nil && tags.HasTag(item, tag) {
+				return true
+			}
+		} else if entry == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Items lists the concrete items matching the ingredient
+func (ing Ingredient) Items(tags ItemTags) []string {
+	var items []string
+	for _, entry := range ing {
+		if tag, ok := strings.CutPrefix(entry, "#"); ok {
+			if tags != nil {
+				items = append(items, tags.TagItems(tag)...)
+			}
+		} else {
+			items = append(items, entry)
+		}
+	}
+	return items
+}
+
+// RecipeResult is the item produced by a recipe
+type RecipeResult struct {
+	Item  string // Item name
+	Count int    // Items per craft
+}
+
+// Recipe is a crafting, cooking, stonecutting or smithing recipe
+type Recipe struct {
+	ID          string       // Recipe ID (e.g. "minecraft:iron_pickaxe")
+	Type        RecipeType   // Recipe type
+	Category    string       // Recipe book category
+	Group       string       // Recipe book group
+	Width       int          // Shaped: pattern width
+	Height      int          // Shaped: pattern height
+	Pattern     []Ingredient // Shaped: Width*Height cells, row by row (nil for empty cells)
+	Ingredients []Ingredient // Shapeless ingredients, or the single input of cooking and stonecutting
+	Template    Ingredient   // Smithing template
+	Base        Ingredient   // Smithing base item
+	Addition    Ingredient   // Smithing addition
+	Result      RecipeResult // Result (empty for special and trim recipes)
+	Experience  float64      // Cooking experience
+	CookingTime int          // Cooking time in ticks
+}
+
+// Inputs returns the ingredients consumed by one craft
+func (r *Recipe) Inputs() []Ingredient {
+	switch {
+	case r.Type == RecipeShaped:
+		inputs := make([]Ingredient, 0, len(r.Pattern))
+		for _, cell := range r.Pattern {
+			if cell != nil {
+				inputs = append(inputs, cell)
+			}
+		}
+		return inputs
+	case r.Type == RecipeSmithingTransform || r.Type == RecipeSmithingTrim:
+		return []Ingredient{r.Template, r.Base, r.Addition}
+	}
+	return r.Ingredients
+}
+
+// FitsGrid checks if a crafting recipe fits a size x size grid
+func (r *Recipe) FitsGrid(size int) bool {
+	switch r.Type {
+	case RecipeShaped:
+		return r.Width <= size && r.Height <= size
+	case RecipeShapeless:
+		return len(r.Ingredients) <= size*size
+	}
+	return false
+}
+
+// RecipeBook indexes recipes for matching and planning
+type RecipeBook struct {
+	Recipes []*Recipe // All recipes, sorted by ID
+	Tags    ItemTags  // Tag resolver for tag ingredients (nil matches no tags)
+
+	byID     map[string]*Recipe
+	byResult map[string][]*Recipe
+}
+
+// NewRecipeBook creates a recipe book
+func NewRecipeBook(recipes []Recipe, tags ItemTags) *RecipeBook {
+	b := &RecipeBook{
+		Tags:     tags,
+		byID:     make(map[string]*Recipe),
+		byResult: make(map[string][]*Recipe),
+	}
+	for i := range recipes {
+		r := &recipes[i]
+		b.Recipes = append(b.Recipes, r)
+		b.byID[r.ID] = r
+		if r.Result.Item != "" {
+			b.byResult[r.Result.Item] = append(b.byResult[r.Result.Item], r)
+		}
+	}
+	sort.Slice(b.Recipes, func(i, j int) bool { return b.Recipes[i].ID < b.Recipes[j].ID })
+	for _, list := range b.byResult {
+		sort.Slice(list, func(i, j int) bool { return list[i].ID < list[j].ID })
+	}
+	return b
+}
+
+// Get returns a recipe by ID
+func (b *RecipeBook) Get(id string) (*Recipe, bool) {
+	r, ok := b.byID[id]
+	return r, ok
+}
+
+// ForResult returns the recipes producing an item
+func (b *RecipeBook) ForResult(item string) []*Recipe {
+	return b.byResult[item]
+}
+
+// ByType returns the recipes of the given types
+func (b *RecipeBook) ByType(types ...RecipeType) []*Recipe {
+	var recipes []*Recipe
+	for _, r := range b.Recipes {
+		for _, t := range types {
+			if r.Type == t {
+				recipes = append(recipes, r)
+				break
+			}
+		}
+	}
+	return recipes
+}
+
+// MatchGrid returns the shaped or shapeless recipe matching a crafting grid.
+// The grid holds size*size stacks row by row (size 2 for the inventory, 3 for a crafting table).
+func (b *RecipeBook) MatchGrid(grid []ItemStack, size int) (*Recipe, bool) {
+	if len(grid) < size*size {
+		return nil, false
+	}
+
+	minX, minY, maxX, maxY := size, size, -1, -1
+	var items []string
+	for y := 0; y < size; y++ {
+		for x := 0; x < size; x++ {
+			if grid[y*size+x].IsEmpty() {
+				continue
+			}
+			minX, minY = min(minX, x), min(minY, y)
+			maxX, maxY = max(maxX, x), max(maxY, y)
+			items = append(items, grid[y*size+x].Item.Name)
+		}
+	}
+	if len(items) == 0 {
+		return nil, false
+	}
+	width, height := maxX-minX+1, maxY-minY+1
+	cell := func(x, y int) string {
+		stack := &grid[(minY+y)*size+minX+x]
+		if stack.IsEmpty() {
+			return ""
+		}
+		return stack.Item.Name
+	}
+
+	for _, r := range b.Recipes {
+		switch r.Type {
+		case RecipeShaped:
+			if r.Width == width && r.Height == height &&
+				(b.matchShaped(r, cell, false) || b.matchShaped(r, cell, true)) {
+				return r, true
+			}
+		case RecipeShapeless:
+			if len(r.Ingredients) == len(items) && b.matchShapeless(r.Ingredients, items) {
+				return r, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (b *RecipeBook) matchShaped(r *Recipe, cell func(x, y int) string, mirrored bool) bool {
+	for y := 0; y < r.Height; y++ {
+		for x := 0; x < r.Width; x++ {
+			px := x
+			if mirrored {
+				px = r.Width - 1 - x
+			}
+			want := r.Pattern[y*r.Width+px]
+			item := cell(x, y)
+			if want == nil {
+				if item != "" {
+					return false
+				}
+			} else if item == "" || !want.Matches(item, b.Tags) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// matchShapeless assigns every item to a distinct ingredient
+func (b *RecipeBook) matchShapeless(ingredients []Ingredient, items []string) bool {
+	used := make([]bool, len(ingredients))
+	var assign func(i int) bool
+	assign = func(i int) bool {
+		if i == len(items) {
+			return true
+		}
+		for j, ing := range ingredients {
+			if used[j] || !ing.Matches(items[i], b.Tags) {
+				continue
+			}
+			used[j] = true
+			if assign(i + 1) {
+				return true
+			}
+			used[j] = false
+		}
+		return false
+	}
+	return assign(0)
+}
+
+// MatchCooking returns the cooking recipe of the given type for an input item
+func (b *RecipeBook) MatchCooking(t RecipeType, input string) (*Recipe, bool) {
+	for _, r := range b.Recipes {
+		if r.Type == t && len(r.Ingredients) == 1 && r.Ingredients[0].Matches(input, b.Tags) {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// MatchStonecutting returns the stonecutter recipes for an input item
+func (b *RecipeBook) MatchStonecutting(input string) []*Recipe {
+	var recipes []*Recipe
+	for _, r := range b.Recipes {
+		if r.Type == RecipeStonecutting && len(r.Ingredients) == 1 && r.Ingredients[0].Matches(input, b.Tags) {
+			recipes = append(recipes, r)
+		}
+	}
+	return recipes
+}
+
+// MatchSmithing returns the smithing recipe for a template, base and addition
+func (b *RecipeBook) MatchSmithing(template, base, addition string) (*Recipe, bool) {
+	for _, r := range b.Recipes {
+		if (r.Type == RecipeSmithingTransform || r.Type == RecipeSmithingTrim) &&
+			r.Template.Matches(template, b.Tags) && r.Base.Matches(base, b.Tags) && r.Addition.Matches(addition, b.Tags) {
+			return r, true
+		}
+	}
+	return nil, false
+}
+
+// SlotRules returns recipe-based slot rules to use as Container.Rules
+func (b *RecipeBook) SlotRules() map[SlotRule]ItemPredicate {
+	cooking := func(t RecipeType) ItemPredicate {
+		return func(stack *ItemStack) bool {
+			_, ok := b.MatchCooking(t, stack.Item.Name)
+			return ok
+		}
+	}
+	return map[SlotRule]ItemPredicate{
+		RuleSmeltable: cooking(RecipeSmelting),
+		RuleBlastable: cooking(RecipeBlasting),
+		RuleSmokable:  cooking(RecipeSmoking),
+		RuleStonecuttable: func(stack *ItemStack) bool {
+			return len(b.MatchStonecutting(stack.Item.Name)) > 0
+		},
+	}
+}
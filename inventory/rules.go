@@ -0,0 +1,205 @@
+package inventory
+
+import (
+	"slices"
+	"strings"
+)
+
+// SlotRule names a set of items a slot accepts
+type SlotRule string
+
+const (
+	RuleAny               SlotRule = ""
+	RuleHead              SlotRule = "head"
+	RuleChest             SlotRule = "chest"
+	RuleLegs              SlotRule = "legs"
+	RuleFeet              SlotRule = "feet"
+	RuleOffhand           SlotRule = "offhand"
+	RuleSmeltable         SlotRule = "smeltable"
+	RuleBlastable         SlotRule = "blastable"
+	RuleSmokable          SlotRule = "smokable"
+	RuleFuel              SlotRule = "fuel"
+	RuleBrewingBottle     SlotRule = "brewing_bottle"
+	RuleBrewingIngredient SlotRule = "brewing_ingredient"
+	RuleBrewingFuel       SlotRule = "brewing_fuel"
+	RuleLapis             SlotRule = "lapis"
+	RuleBeaconPayment     SlotRule = "beacon_payment"
+	RuleGrindable         SlotRule = "grindable"
+	RuleStonecuttable     SlotRule = "stonecuttable"
+	RuleBanner            SlotRule = "banner"
+	RuleDye               SlotRule = "dye"
+	RuleBannerPattern     SlotRule = "banner_pattern"
+	RuleFilledMap         SlotRule = "filled_map"
+	RuleMapAddition       SlotRule = "map_addition"
+	RuleSmithingTemplate  SlotRule = "smithing_template"
+	RuleSmithingBase      SlotRule = "smithing_base"
+	RuleSmithingAddition  SlotRule = "smithing_addition"
+	RuleShulkerStorage    SlotRule = "shulker_storage"
+)
+
+// ItemPredicate reports whether a stack satisfies a slot rule
+type ItemPredicate func(stack *ItemStack) bool
+
+// DefaultRules decides slot rules from item components and names. Recipe-based rules
+// (smeltable, stonecuttable, ...) are approximations; override them per
+// Container when recipe data is available.
+var DefaultRules = map[SlotRule]ItemPredicate{
+	RuleHead:              equippedIn(EquipHead),
+	RuleChest:             equippedIn(EquipChest),
+	RuleLegs:              equippedIn(EquipLegs),
+	RuleFeet:              equippedIn(EquipFeet),
+	RuleOffhand:           byName(func(n string) bool { return n == "shield" }),
+	RuleSmeltable:         byName(isSmeltable),
+	RuleBlastable:         byName(isBlastable),
+	RuleSmokable:          byName(func(n string) bool { return slices.Contains(rawFoods, n) }),
+	RuleFuel:              byName(isFuel),
+	RuleBrewingBottle:     byName(oneOf("potion", "splash_potion", "lingering_potion", "glass_bottle")),
+	RuleBrewingIngredient: byName(func(n string) bool { return slices.Contains(brewingIngredients, n) }),
+	RuleBrewingFuel:       byName(oneOf("blaze_powder")),
+	RuleLapis:             byName(oneOf("lapis_lazuli")),
+	RuleBeaconPayment:     byName(oneOf("iron_ingot", "gold_ingot", "emerald", "diamond", "netherite_ingot")),
+	RuleGrindable:         isGrindable,
+	RuleStonecuttable:     byName(isStonecuttable),
+	RuleBanner:            byName(func(n string) bool { return strings.HasSuffix(n, "_banner") }),
+	RuleDye:               byName(func(n string) bool { return strings.HasSuffix(n, "_dye") }),
+	RuleBannerPattern:     byName(func(n string) bool { return strings.HasSuffix(n, "_banner_pattern") }),
+	RuleFilledMap:         byName(oneOf("filled_map")),
+	RuleMapAddition:       byName(oneOf("paper", "map", "glass_pane")),
+	RuleSmithingTemplate:  byName(func(n string) bool { return strings.HasSuffix(n, "_smithing_template") }),
+	RuleSmithingBase:      byName(func(n string) bool { return isArmor(n) || strings.HasPrefix(n, "diamond_") && isGear(n) }),
+	RuleSmithingAddition:  byName(func(n string) bool { return slices.Contains(trimMaterials, n) }),
+	RuleShulkerStorage:    byName(func(n string) bool { return !strings.HasSuffix(n, "shulker_box") }),
+}
+
+var rawFoods = []string{"beef", "porkchop", "chicken", "mutton", "rabbit", "cod", "salmon", "potato", "kelp"}
+
+var brewingIngredients = []string{
+	"nether_wart", "redstone", "glowstone_dust", "fermented_spider_eye", "gunpowder", "dragon_breath",
+	"sugar", "rabbit_foot", "glistering_melon_slice", "spider_eye", "pufferfish", "magma_cream",
+	"golden_carrot", "blaze_powder", "ghast_tear", "turtle_helmet", "phantom_membrane",
+	"breeze_rod", "slime_block", "cobweb", "stone",
+}
+
+var trimMaterials = []string{
+	"netherite_ingot", "iron_ingot", "copper_ingot", "gold_ingot", "lapis_lazuli", "emerald",
+	"diamond", "redstone", "amethyst_shard", "quartz", "resin_brick",
+}
+
+var woodTypes = []string{"oak", "spruce", "birch", "jungle", "acacia", "dark_oak", "mangrove", "cherry", "pale_oak", "bamboo"}
+
+// byName adapts a predicate on the item name without namespace
+func byName(match func(name string) bool) ItemPredicate {
+	return func(stack *ItemStack) bool {
+		return match(strings.TrimPrefix(stack.Item.Name, "minecraft:"))
+	}
+}
+
+func oneOf(names ...string) func(string) bool {
+	return func(name string) bool {
+		return slices.Contains(names, name)
+	}
+}
+
+// equippedIn matches items whose equippable component names the slot
+func equippedIn(slot string) ItemPredicate {
+	return func(stack *ItemStack) bool {
+		return stack.EquipmentSlot() == slot
+	}
+}
+
+func isArmor(name string) bool {
+	for _, suffix := range []string{"_helmet", "_chestplate", "_leggings", "_boots"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isGear reports whether an item is a tool, weapon or piece of armor
+func isGear(name string) bool {
+	if isArmor(name) {
+		return true
+	}
+	for _, suffix := range []string{"_sword", "_pickaxe", "_axe", "_shovel", "_hoe"} {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return slices.Contains([]string{"bow", "crossbow", "trident", "mace", "shield", "elytra",
+		"fishing_rod", "shears", "flint_and_steel", "carrot_on_a_stick", "warped_fungus_on_a_stick", "brush"}, name)
+}
+
+// isWooden reports whether an item is made of an overworld wood type
+func isWooden(name string) bool {
+	name = strings.TrimPrefix(name, "stripped_")
+	for _, wood := range woodTypes {
+		if strings.HasPrefix(name, wood+"_") {
+			return true
+		}
+	}
+	return false
+}
+
+func isSmeltable(name string) bool {
+	if isBlastable(name) || slices.Contains(rawFoods, name) {
+		return true
+	}
+	if isWooden(name) && (strings.HasSuffix(name, "_log") || strings.HasSuffix(name, "_wood")) {
+		return true
+	}
+	return slices.Contains([]string{"sand", "red_sand", "cobblestone", "stone", "cobbled_deepslate",
+		"clay_ball", "clay", "netherrack", "cactus", "wet_sponge", "sea_pickle", "chorus_fruit",
+		"stone_bricks", "sandstone", "red_sandstone", "quartz_block", "basalt", "nether_bricks"}, name)
+}
+
+func isBlastable(name string) bool {
+	switch {
+	case strings.HasSuffix(name, "_ore"), name == "ancient_debris":
+		return true
+	case strings.HasPrefix(name, "raw_") && !strings.HasSuffix(name, "_block"):
+		return true
+	case isGear(name):
+		return strings.HasPrefix(name, "iron_") || strings.HasPrefix(name, "golden_") || strings.HasPrefix(name, "chainmail_")
+	}
+	return false
+}
+
+func isFuel(name string) bool {
+	if slices.Contains([]string{"coal", "charcoal", "coal_block", "lava_bucket", "blaze_rod",
+		"dried_kelp_block", "bamboo", "stick", "scaffolding", "bookshelf", "crafting_table", "chest",
+		"trapped_chest", "barrel", "ladder", "bow", "crossbow", "fishing_rod", "bowl"}, name) {
+		return true
+	}
+	if strings.HasPrefix(name, "wooden_") || strings.HasSuffix(name, "_wool") || strings.HasSuffix(name, "_carpet") {
+		return true
+	}
+	return isWooden(name) && !strings.HasSuffix(name, "_leaves")
+}
+
+func isGrindable(stack *ItemStack) bool {
+	name := strings.TrimPrefix(stack.Item.Name, "minecraft:")
+	if name == "enchanted_book" || stack.MaxDamage() > 0 {
+		return true
+	}
+	return len(stack.Enchantments()) > 0 || len(stack.StoredEnchantments()) > 0
+}
+
+func isStonecuttable(name string) bool {
+	for _, suffix := range []string{"_ore", "_slab", "_stairs", "_wall", "_button", "_pressure_plate"} {
+		if strings.HasSuffix(name, suffix) {
+			return false
+		}
+	}
+	if isGear(name) || strings.HasPrefix(name, "redstone") {
+		return false
+	}
+	for _, base := range []string{"stone", "granite", "diorite", "andesite", "deepslate", "blackstone",
+		"basalt", "copper", "quartz", "prismarine", "purpur", "bricks", "tuff", "end_stone"} {
+		if strings.Contains(name, base) {
+			return !slices.Contains([]string{"glowstone", "lodestone", "grindstone", "stonecutter",
+				"copper_ingot", "raw_copper", "quartz", "glowstone_dust"}, name)
+		}
+	}
+	return false
+}
@@ -3,17 +3,41 @@ package inventory
 // WindowType represents the type of container window
 type WindowType string
 
+// Menu types as sent by the server (minecraft:menu registry)
+const (
+	WindowTypeGeneric9x1       WindowType = "minecraft:generic_9x1"
+	WindowTypeGeneric9x2       WindowType = "minecraft:generic_9x2"
+	WindowTypeGeneric9x3       WindowType = "minecraft:generic_9x3"
+	WindowTypeGeneric9x4       WindowType = "minecraft:generic_9x4"
+	WindowTypeGeneric9x5       WindowType = "minecraft:generic_9x5"
+	WindowTypeGeneric9x6       WindowType = "minecraft:generic_9x6"
+	WindowTypeGeneric3x3       WindowType = "minecraft:generic_3x3"
+	WindowTypeCrafter3x3       WindowType = "minecraft:crafter_3x3"
+	WindowTypeAnvil            WindowType = "minecraft:anvil"
+	WindowTypeBeacon           WindowType = "minecraft:beacon"
+	WindowTypeBlastFurnace     WindowType = "minecraft:blast_furnace"
+	WindowTypeBrewingStand     WindowType = "minecraft:brewing_stand"
+	WindowTypeCrafting         WindowType = "minecraft:crafting"
+	WindowTypeEnchantment      WindowType = "minecraft:enchantment"
+	WindowTypeFurnace          WindowType = "minecraft:furnace"
+	WindowTypeGrindstone       WindowType = "minecraft:grindstone"
+	WindowTypeHopper           WindowType = "minecraft:hopper"
+	WindowTypeLoom             WindowType = "minecraft:loom"
+	WindowTypeMerchant         WindowType = "minecraft:merchant"
+	WindowTypeShulkerBox       WindowType = "minecraft:shulker_box"
+	WindowTypeSmithing         WindowType = "minecraft:smithing"
+	WindowTypeSmoker           WindowType = "minecraft:smoker"
+	WindowTypeCartographyTable WindowType = "minecraft:cartography_table"
+	WindowTypeStonecutter      WindowType = "minecraft:stonecutter"
+	WindowTypePlayer           WindowType = "minecraft:player" // Player inventory (window ID 0, not a registry entry)
+)
+
+// Block-named window types (resolved to menu types by LayoutFor)
 const (
 	WindowTypeChest         WindowType = "minecraft:chest"
 	WindowTypeCraftingTable WindowType = "minecraft:crafting_table"
-	WindowTypeFurnace       WindowType = "minecraft:furnace"
 	WindowTypeDispenser     WindowType = "minecraft:dispenser"
 	WindowTypeEnchanting    WindowType = "minecraft:enchanting_table"
-	WindowTypeBrewingStand  WindowType = "minecraft:brewing_stand"
-	WindowTypeAnvil         WindowType = "minecraft:anvil"
-	WindowTypeBeacon        WindowType = "minecraft:beacon"
-	WindowTypeHopper        WindowType = "minecraft:hopper"
-	WindowTypeShulkerBox    WindowType = "minecraft:shulker_box"
 )
 
 // Window represents a container window (chest, furnace, etc.)
@@ -24,6 +24,15 @@ func NewAABBFromBlock(pos world.Position) *AABB {
 	}
 }
 
+// NewEntityAABB creates the bounding box of an entity standing at pos (feet position)
+func NewEntityAABB(pos world.Vec3d, width, height float64) *AABB {
+	half := width / 2
+	return &AABB{
+		MinX: pos.X - half, MinY: pos.Y, MinZ: pos.Z - half,
+		MaxX: pos.X + half, MaxY: pos.Y + height, MaxZ: pos.Z + half,
+	}
+}
+
 // Intersects checks if this AABB intersects with another
 func (a *AABB) Intersects(b *AABB) bool {
 	return a.MaxX > b.MinX && a.MinX < b.MaxX &&
@@ -71,3 +80,34 @@ func (a *AABB) Offset(offset world.Vec3d) *AABB {
 		MaxX: a.MaxX + offset.X, MaxY: a.MaxY + offset.Y, MaxZ: a.MaxZ + offset.Z,
 	}
 }
+
+// IntersectSegment returns the smallest t in [0, 1] at which the segment from -> to
+// enters the AABB (0 if it starts inside)
+func (a *AABB) IntersectSegment(from, to world.Vec3d) (float64, bool) {
+	tMin, tMax := 0.0, 1.0
+	axes := [3][4]float64{
+		{from.X, to.X - from.X, a.MinX, a.MaxX},
+		{from.Y, to.Y - from.Y, a.MinY, a.MaxY},
+		{from.Z, to.Z - from.Z, a.MinZ, a.MaxZ},
+	}
+	for _, axis := range axes {
+		start, delta, lo, hi := axis[0], axis[1], axis[2], axis[3]
+		if delta == 0 {
+			if start < lo || start > hi {
+				return 0, false
+			}
+			continue
+		}
+		t1 := (lo - start) / delta
+		t2 := (hi - start) / delta
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		tMin = max(tMin, t1)
+		tMax = min(tMax, t2)
+		if tMin > tMax {
+			return 0, false
+		}
+	}
+	return tMin, true
+}
@@ -0,0 +1,170 @@
+package physics
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/konjacbot/prismarine-go/data"
+	"github.com/konjacbot/prismarine-go/entity"
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// Explosion powers of common sources
+const (
+	PowerWitherSkull    = 1.0
+	PowerFireball       = 1.0
+	PowerCreeper        = 3.0
+	PowerTNT            = 4.0
+	PowerBed            = 5.0
+	PowerRespawnAnchor  = 5.0
+	PowerChargedCreeper = 6.0
+	PowerEndCrystal     = 6.0
+	PowerWitherSpawn    = 7.0
+	explosionRayStep    = 0.3
+	explosionRayDecay   = 0.22500001
+)
+
+// Explosion describes an explosion at a point
+type Explosion struct {
+	Center world.Vec3d
+	Power  float64
+}
+
+// DamageOptions describes the target of an explosion for damage calculation
+type DamageOptions struct {
+	Difficulty      world.Difficulty // Scales damage to players
+	Player          bool             // Apply difficulty scaling (players only)
+	Armor           float64          // Total armor points
+	Toughness       float64          // Total armor toughness
+	Protection      int              // Sum of Protection levels on worn armor
+	BlastProtection int              // Sum of Blast Protection levels on worn armor
+	Filter          BlockFilter      // Blocks that shield from the blast (default SolidBlocks)
+}
+
+// BlockOptions controls which blocks are considered destroyed by an explosion
+type BlockOptions struct {
+	Registry *data.Registry // Registry for blast resistance (default data.DefaultRegistry)
+	Rand     *rand.Rand     // Random source for ray intensity; nil uses the average intensity
+}
+
+// Radius returns the radius within which entities are damaged
+func (e Explosion) Radius() float64 {
+	return e.Power * 2
+}
+
+// Exposure returns the fraction (0-1) of rays from the target box to the explosion center
+// that are not blocked, matching vanilla's "seen percent" sampling
+func (e Explosion) Exposure(w world.World, box *AABB, filter BlockFilter) float64 {
+	size := box.Size()
+	dx := 1 / (size.X*2 + 1)
+	dy := 1 / (size.Y*2 + 1)
+	dz := 1 / (size.Z*2 + 1)
+	if dx < 0 || dy < 0 || dz < 0 {
+		return 0
+	}
+
+	offsetX := (1 - math.Floor(1/dx)*dx) / 2
+	offsetZ := (1 - math.Floor(1/dz)*dz) / 2
+
+	seen, total := 0, 0
+	for fx := 0.0; fx <= 1; fx += dx {
+		for fy := 0.0; fy <= 1; fy += dy {
+			for fz := 0.0; fz <= 1; fz += dz {
+				sample := world.Vec3d{
+					X: lerp(fx, box.MinX, box.MaxX) + offsetX,
+					Y: lerp(fy, box.MinY, box.MaxY),
+					Z: lerp(fz, box.MinZ, box.MaxZ) + offsetZ,
+				}
+				if _, hit := RaycastBlocks(w, sample, e.Center, filter); !hit {
+					seen++
+				}
+				total++
+			}
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(seen) / float64(total)
+}
+
+// RawDamage returns the damage before armor and enchantments for an entity whose
+// feet are at pos and whose bounding box is box
+func (e Explosion) RawDamage(w world.World, pos world.Vec3d, box *AABB, filter BlockFilter) float64 {
+	radius := e.Radius()
+	dist := pos.Distance(e.Center) / radius
+	if dist > 1 {
+		return 0
+	}
+
+	impact := (1 - dist) * e.Exposure(w, box, filter)
+	return (impact*impact+impact)/2*7*radius + 1
+}
+
+// Damage returns the final damage dealt to a target after difficulty scaling,
+// armor, toughness and protection enchantments
+func (e Explosion) Damage(w world.World, pos world.Vec3d, box *AABB, opts DamageOptions) float64 {
+	damage := e.RawDamage(w, pos, box, opts.Filter)
+	if damage == 0 {
+		return 0
+	}
+	if opts.Player {
+		damage = entity.ScaleDamageForDifficulty(damage, opts.Difficulty)
+	}
+	damage = entity.DamageAfterArmor(damage, opts.Armor, opts.Toughness)
+	return entity.DamageAfterProtection(damage, opts.Protection+opts.BlastProtection*2)
+}
+
+// DestroyedBlocks returns the blocks the explosion would destroy, using the vanilla
+// 16x16x16 ray grid and per-block blast resistance
+func (e Explosion) DestroyedBlocks(w world.World, opts BlockOptions) []world.Position {
+	reg := opts.Registry
+	if reg == nil {
+		reg = data.DefaultRegistry
+	}
+
+	seen := make(map[world.Position]bool)
+	destroyed := []world.Position{}
+
+	for i := 0; i < 16; i++ {
+		for j := 0; j < 16; j++ {
+			for k := 0; k < 16; k++ {
+				if i != 0 && i != 15 && j != 0 && j != 15 && k != 0 && k != 15 {
+					continue
+				}
+
+				dir := world.Vec3d{X: float64(i)/15*2 - 1, Y: float64(j)/15*2 - 1, Z: float64(k)/15*2 - 1}
+				length := math.Sqrt(dir.X*dir.X + dir.Y*dir.Y + dir.Z*dir.Z)
+				dir = world.Vec3d{X: dir.X / length * explosionRayStep, Y: dir.Y / length * explosionRayStep, Z: dir.Z / length * explosionRayStep}
+
+				intensity := e.Power
+				if opts.Rand != nil {
+					intensity *= 0.7 + opts.Rand.Float64()*0.6
+				}
+
+				point := e.Center
+				for ; intensity > 0; intensity -= explosionRayDecay {
+					pos := point.ToPosition()
+					block, err := w.GetBlock(pos)
+					if err == nil && !block.IsAir() {
+						resistance := 0.0
+						if info, ok := reg.GetBlock(block.ID); ok {
+							resistance = info.Resistance
+						}
+						intensity -= (resistance + 0.3) * explosionRayStep
+						if intensity > 0 && !seen[pos] {
+							seen[pos] = true
+							destroyed = append(destroyed, pos)
+						}
+					}
+					point = point.Add(dir)
+				}
+			}
+		}
+	}
+	return destroyed
+}
+
+func lerp(t, a, b float64) float64 {
+	return a + t*(b-a)
+}
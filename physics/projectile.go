@@ -0,0 +1,334 @@
+package physics
+
+import (
+	"math"
+
+	"github.com/konjacbot/prismarine-go/data"
+	"github.com/konjacbot/prismarine-go/entity"
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// ProjectileType identifies a kind of projectile
+type ProjectileType int
+
+const (
+	ProjectileArrow ProjectileType = iota
+	ProjectileTrident
+	ProjectileSnowball
+	ProjectileEgg
+	ProjectileEnderPearl
+	ProjectileSplashPotion
+	ProjectileExperienceBottle
+	ProjectileFirework // Rocket shot from a crossbow (flies straight)
+	ProjectileWindCharge
+	ProjectileFireworkRising // Rocket used on the ground, launched straight up (pitch -90); accelerates
+)
+
+// ProjectileProperties holds the per-tick motion parameters of a projectile
+type ProjectileProperties struct {
+	Gravity     float64 // Subtracted from vertical velocity every tick
+	Drag        float64 // Velocity multiplier per tick in air
+	WaterDrag   float64 // Velocity multiplier per tick in water
+	Speed       float64 // Launch speed at full power
+	PitchOffset float32 // Added to the pitch of the vertical launch component only (degrees)
+	Size        float64 // Bounding box width and height
+	Boost       float64 // Horizontal acceleration multiplier per tick (rising fireworks), 0 for none
+	BoostY      float64 // Vertical acceleration per tick (rising fireworks)
+}
+
+// Projectiles stores the motion parameters of each projectile type
+var Projectiles = map[ProjectileType]ProjectileProperties{
+	ProjectileArrow:            {Gravity: 0.05, Drag: 0.99, WaterDrag: 0.6, Speed: 3.0, Size: 0.5},
+	ProjectileTrident:          {Gravity: 0.05, Drag: 0.99, WaterDrag: 0.99, Speed: 2.5, Size: 0.5},
+	ProjectileSnowball:         {Gravity: 0.03, Drag: 0.99, WaterDrag: 0.8, Speed: 1.5, Size: 0.25},
+	ProjectileEgg:              {Gravity: 0.03, Drag: 0.99, WaterDrag: 0.8, Speed: 1.5, Size: 0.25},
+	ProjectileEnderPearl:       {Gravity: 0.03, Drag: 0.99, WaterDrag: 0.8, Speed: 1.5, Size: 0.25},
+	ProjectileSplashPotion:     {Gravity: 0.05, Drag: 0.99, WaterDrag: 0.8, Speed: 0.5, PitchOffset: -20, Size: 0.25},
+	ProjectileExperienceBottle: {Gravity: 0.07, Drag: 0.99, WaterDrag: 0.8, Speed: 0.7, PitchOffset: -20, Size: 0.25},
+	ProjectileFirework:         {Gravity: 0, Drag: 1, WaterDrag: 1, Speed: 1.6, Size: 0.25},
+	ProjectileWindCharge:       {Gravity: 0, Drag: 1, WaterDrag: 1, Speed: 1.5, Size: 0.3125},
+	ProjectileFireworkRising:   {Gravity: 0, Drag: 1, WaterDrag: 1, Speed: 0.05, Size: 0.25, Boost: 1.15, BoostY: 0.04},
+}
+
+// Trajectory is the result of a projectile simulation
+type Trajectory struct {
+	Path      []world.Vec3d  // Position at the start of every tick, ending at the impact point
+	Ticks     int            // Ticks until impact (or until the simulation stopped)
+	BlockHit  *BlockHit      // Block that was hit, if any
+	EntityHit *entity.Entity // Entity that was hit, if any
+	Impact    world.Vec3d    // Final position
+}
+
+// Hit returns true if the projectile hit a block or an entity
+func (t *Trajectory) Hit() bool {
+	return t.BlockHit != nil || t.EntityHit != nil
+}
+
+// SimulateOptions controls a projectile simulation
+type SimulateOptions struct {
+	MaxTicks   int                                       // Simulation limit (default 200)
+	Filter     BlockFilter                               // Blocks that stop the projectile (default SolidBlocks)
+	Entities   []*entity.Entity                          // Entities that can be hit
+	Shooter    int32                                     // Entity ID that is never hit (the shooter)
+	EntitySize func(e *entity.Entity) (float64, float64) // Width and height of an entity (default entity.EntityDimensions)
+}
+
+// Direction returns the unit look vector for a rotation (yaw, pitch in degrees)
+func Direction(rot world.Vec2) world.Vec3d {
+	yaw := float64(rot.X) * math.Pi / 180
+	pitch := float64(rot.Y) * math.Pi / 180
+	return world.Vec3d{
+		X: -math.Sin(yaw) * math.Cos(pitch),
+		Y: -math.Sin(pitch),
+		Z: math.Cos(yaw) * math.Cos(pitch),
+	}
+}
+
+// LaunchVelocity returns the initial velocity of a projectile shot with the given rotation.
+// power scales the launch speed (e.g. bow charge 0-1). The shooter's own motion is not included.
+func LaunchVelocity(kind ProjectileType, rot world.Vec2, power float64) world.Vec3d {
+	props := Projectiles[kind]
+	dir := launchDirection(rot, props.PitchOffset)
+	speed := props.Speed * power
+	return world.Vec3d{X: dir.X * speed, Y: dir.Y * speed, Z: dir.Z * speed}
+}
+
+// launchDirection returns the normalized launch direction for a rotation the way
+// vanilla shootFromRotation builds it: the pitch offset only tilts the vertical part
+func launchDirection(rot world.Vec2, pitchOffset float32) world.Vec3d {
+	yaw := float64(rot.X) * math.Pi / 180
+	pitch := float64(rot.Y) * math.Pi / 180
+	offset := float64(rot.Y+pitchOffset) * math.Pi / 180
+	dir := world.Vec3d{
+		X: -math.Sin(yaw) * math.Cos(pitch),
+		Y: -math.Sin(offset),
+		Z: math.Cos(yaw) * math.Cos(pitch),
+	}
+	length := math.Sqrt(dir.X*dir.X + dir.Y*dir.Y + dir.Z*dir.Z)
+	if length < 1e-4 {
+		return world.Vec3d{}
+	}
+	return world.Vec3d{X: dir.X / length, Y: dir.Y / length, Z: dir.Z / length}
+}
+
+// Simulate steps a projectile tick by tick from pos with velocity vel until it hits
+// a block or an entity, or MaxTicks is reached
+func Simulate(w world.World, kind ProjectileType, pos, vel world.Vec3d, opts SimulateOptions) Trajectory {
+	props := Projectiles[kind]
+	maxTicks := opts.MaxTicks
+	if maxTicks <= 0 {
+		maxTicks = 200
+	}
+	entitySize := opts.EntitySize
+	if entitySize == nil {
+		entitySize = defaultEntitySize
+	}
+
+	traj := Trajectory{Path: []world.Vec3d{pos}}
+	for tick := 1; tick <= maxTicks; tick++ {
+		next := pos.Add(vel)
+
+		blockT := math.Inf(1)
+		hit, blocked := RaycastBlocks(w, pos, next, opts.Filter)
+		if blocked {
+			blockT = segmentParam(pos, next, hit.Point)
+		}
+
+		// Entities are hit if the segment passes through their box grown by the projectile size
+		var target *entity.Entity
+		entityT := math.Inf(1)
+		for _, e := range opts.Entities {
+			if e.EID == opts.Shooter {
+				continue
+			}
+			width, height := entitySize(e)
+			box := NewEntityAABB(e.Position, width, height).Expand(props.Size / 2)
+			if t, ok := box.IntersectSegment(pos, next); ok && t < entityT {
+				target, entityT = e, t
+			}
+		}
+
+		switch {
+		case target != nil && entityT <= blockT:
+			traj.EntityHit = target
+			traj.Impact = lerpVec(pos, next, entityT)
+		case blocked:
+			traj.BlockHit = hit
+			traj.Impact = hit.Point
+		}
+		if traj.Hit() {
+			traj.Path = append(traj.Path, traj.Impact)
+			traj.Ticks = tick
+			return traj
+		}
+
+		pos = next
+		traj.Path = append(traj.Path, pos)
+		vel = stepVelocity(props, vel, isWater(w, pos))
+	}
+
+	traj.Ticks = maxTicks
+	traj.Impact = pos
+	return traj
+}
+
+// stepVelocity applies one tick of drag, gravity and boost
+func stepVelocity(props ProjectileProperties, vel world.Vec3d, inWater bool) world.Vec3d {
+	if props.Boost > 0 {
+		return world.Vec3d{X: vel.X * props.Boost, Y: vel.Y + props.BoostY, Z: vel.Z * props.Boost}
+	}
+	drag := props.Drag
+	if inWater {
+		drag = props.WaterDrag
+	}
+	return world.Vec3d{X: vel.X * drag, Y: vel.Y*drag - props.Gravity, Z: vel.Z * drag}
+}
+
+// AimOptions controls the aim solver
+type AimOptions struct {
+	HighArc        bool        // Prefer the high (lob) solution instead of the direct one
+	Power          float64     // Launch power (default 1)
+	TargetVelocity world.Vec3d // Target motion per tick, used to lead moving targets
+	MaxTicks       int         // Longest flight considered (default 200)
+}
+
+// AimSolution is a rotation that hits a target
+type AimSolution struct {
+	Rotation world.Vec2  // Yaw and pitch to shoot with
+	Ticks    int         // Flight time in ticks
+	Target   world.Vec3d // Point aimed at (the predicted position for moving targets)
+}
+
+// SolveAim finds the rotation needed for a projectile launched at from to pass through
+// target, ignoring obstacles. Returns false if the target is out of range.
+func SolveAim(kind ProjectileType, from, target world.Vec3d, opts AimOptions) (AimSolution, bool) {
+	power := opts.Power
+	if power <= 0 {
+		power = 1
+	}
+	maxTicks := opts.MaxTicks
+	if maxTicks <= 0 {
+		maxTicks = 200
+	}
+
+	aim := target
+	var solution AimSolution
+	var ok bool
+	// Iterate the lead: predict where the target will be after the flight time
+	for i := 0; i < 5; i++ {
+		solution, ok = solveStatic(kind, from, aim, power, opts.HighArc, maxTicks)
+		if !ok || opts.TargetVelocity == (world.Vec3d{}) {
+			break
+		}
+		t := float64(solution.Ticks)
+		aim = target.Add(world.Vec3d{X: opts.TargetVelocity.X * t, Y: opts.TargetVelocity.Y * t, Z: opts.TargetVelocity.Z * t})
+	}
+	return solution, ok
+}
+
+// solveStatic searches the launch pitch whose flight passes the target height at the
+// target's horizontal distance
+func solveStatic(kind ProjectileType, from, target world.Vec3d, power float64, high bool, maxTicks int) (AimSolution, bool) {
+	props := Projectiles[kind]
+	dx := target.X - from.X
+	dz := target.Z - from.Z
+	dist := math.Hypot(dx, dz)
+	dy := target.Y - from.Y
+	yaw := float32(math.Atan2(-dx, dz) * 180 / math.Pi)
+
+	// miss returns the height error at the target distance for a given launch pitch
+	miss := func(pitch float64) (float64, int, bool) {
+		y, ticks, ok := flightHeightAt(props, props.Speed*power, pitch, dist, maxTicks)
+		return y - dy, ticks, ok
+	}
+
+	// Scan pitches from looking straight up to straight down, collecting sign changes
+	type bracket struct{ lo, hi float64 }
+	brackets := []bracket{}
+	prevPitch := -90.0
+	prevErr, _, prevOK := miss(prevPitch)
+	for pitch := -89.5; pitch <= 90; pitch += 0.5 {
+		err, _, ok := miss(pitch)
+		if ok && prevOK && (err == 0 || (err > 0) != (prevErr > 0)) {
+			brackets = append(brackets, bracket{prevPitch, pitch})
+		}
+		prevPitch, prevErr, prevOK = pitch, err, ok
+	}
+	if len(brackets) == 0 {
+		return AimSolution{}, false
+	}
+
+	// The steepest upward solution is the high arc, the last one the direct shot
+	b := brackets[len(brackets)-1]
+	if high {
+		b = brackets[0]
+	}
+	loErr, _, _ := miss(b.lo)
+	for i := 0; i < 40; i++ {
+		mid := (b.lo + b.hi) / 2
+		midErr, _, _ := miss(mid)
+		if (midErr > 0) == (loErr > 0) {
+			b.lo, loErr = mid, midErr
+		} else {
+			b.hi = mid
+		}
+	}
+
+	pitch := (b.lo + b.hi) / 2
+	_, ticks, _ := miss(pitch)
+	return AimSolution{
+		Rotation: world.Vec2{X: yaw, Y: float32(pitch)},
+		Ticks:    ticks,
+		Target:   target,
+	}, true
+}
+
+// flightHeightAt simulates a flight in open air and returns the height relative to the
+// launch point when the projectile reaches the horizontal distance dist
+func flightHeightAt(props ProjectileProperties, speed, pitch, dist float64, maxTicks int) (float64, int, bool) {
+	dir := launchDirection(world.Vec2{Y: float32(pitch)}, props.PitchOffset)
+	h, y := 0.0, 0.0
+	vh, vy := speed*math.Hypot(dir.X, dir.Z), speed*dir.Y
+	if dist == 0 {
+		return 0, 0, vh == 0
+	}
+
+	for tick := 1; tick <= maxTicks; tick++ {
+		nh, ny := h+vh, y+vy
+		if nh >= dist {
+			t := (dist - h) / (nh - h)
+			return y + (ny-y)*t, tick, true
+		}
+		h, y = nh, ny
+		vel := stepVelocity(props, world.Vec3d{X: vh, Y: vy}, false)
+		vh, vy = vel.X, vel.Y
+		if vh <= 1e-9 {
+			return 0, tick, false
+		}
+	}
+	return 0, maxTicks, false
+}
+
+func defaultEntitySize(e *entity.Entity) (float64, float64) {
+	if info, ok := entity.EntityDimensions[e.Type]; ok {
+		return info.Width, info.Height
+	}
+	return 0.6, 1.8
+}
+
+func isWater(w world.World, pos world.Vec3d) bool {
+	block, err := w.GetBlock(pos.ToPosition())
+	return err == nil && block.ID == data.BlockNameToID["water"]
+}
+
+func segmentParam(from, to, point world.Vec3d) float64 {
+	length := from.Distance(to)
+	if length == 0 {
+		return 0
+	}
+	return from.Distance(point) / length
+}
+
+func lerpVec(from, to world.Vec3d, t float64) world.Vec3d {
+	return world.Vec3d{X: lerp(t, from.X, to.X), Y: lerp(t, from.Y, to.Y), Z: lerp(t, from.Z, to.Z)}
+}
@@ -0,0 +1,92 @@
+package physics
+
+import (
+	"math"
+
+	"github.com/konjacbot/prismarine-go/data"
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// BlockHit describes where a ray hit a block
+type BlockHit struct {
+	Pos    world.Position // Position of the block that was hit
+	Point  world.Vec3d    // Point where the ray entered the block
+	Normal world.Position // Face normal of the entered face (zero if the ray started inside)
+}
+
+// BlockFilter decides whether a block stops a ray
+type BlockFilter func(block world.Block) bool
+
+// SolidBlocks is the default filter: blocks marked solid in the data registry stop rays.
+// Blocks are treated as full cubes.
+func SolidBlocks(block world.Block) bool {
+	return data.IsSolid(block.ID)
+}
+
+// RaycastBlocks walks the blocks on the segment from -> to and returns the first block
+// accepted by filter. Unloaded blocks never stop the ray.
+func RaycastBlocks(w world.World, from, to world.Vec3d, filter BlockFilter) (*BlockHit, bool) {
+	if filter == nil {
+		filter = SolidBlocks
+	}
+
+	stops := func(pos world.Position) bool {
+		block, err := w.GetBlock(pos)
+		return err == nil && filter(*block)
+	}
+
+	pos := from.ToPosition()
+	if stops(pos) {
+		return &BlockHit{Pos: pos, Point: from}, true
+	}
+
+	dir := world.Vec3d{X: to.X - from.X, Y: to.Y - from.Y, Z: to.Z - from.Z}
+	stepX, tMaxX, tDeltaX := ddaAxis(from.X, dir.X, pos.X)
+	stepY, tMaxY, tDeltaY := ddaAxis(from.Y, dir.Y, pos.Y)
+	stepZ, tMaxZ, tDeltaZ := ddaAxis(from.Z, dir.Z, pos.Z)
+
+	for {
+		var t float64
+		var normal world.Position
+		switch {
+		case tMaxX <= tMaxY && tMaxX <= tMaxZ:
+			t = tMaxX
+			pos.X += stepX
+			tMaxX += tDeltaX
+			normal = world.Position{X: -stepX}
+		case tMaxY <= tMaxZ:
+			t = tMaxY
+			pos.Y += stepY
+			tMaxY += tDeltaY
+			normal = world.Position{Y: -stepY}
+		default:
+			t = tMaxZ
+			pos.Z += stepZ
+			tMaxZ += tDeltaZ
+			normal = world.Position{Z: -stepZ}
+		}
+		if t >= 1 {
+			return nil, false
+		}
+
+		if stops(pos) {
+			return &BlockHit{
+				Pos:    pos,
+				Point:  world.Vec3d{X: from.X + dir.X*t, Y: from.Y + dir.Y*t, Z: from.Z + dir.Z*t},
+				Normal: normal,
+			}, true
+		}
+	}
+}
+
+// ddaAxis returns the step direction, the ray parameter of the first voxel boundary
+// and the parameter distance between boundaries along one axis
+func ddaAxis(start, delta float64, voxel int) (int, float64, float64) {
+	switch {
+	case delta > 0:
+		return 1, (float64(voxel+1) - start) / delta, 1 / delta
+	case delta < 0:
+		return -1, (float64(voxel) - start) / delta, -1 / delta
+	}
+	return 0, math.Inf(1), math.Inf(1)
+}
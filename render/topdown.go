@@ -0,0 +1,226 @@
+package render
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+
+	"github.com/konjacbot/prismarine-go/data"
+	"github.com/konjacbot/prismarine-go/entity"
+	"github.com/konjacbot/prismarine-go/world"
+)
+
+// EntitySource provides entities to draw on top of a map (e.g. *entity.Tracker)
+type EntitySource interface {
+	All() []*entity.Entity
+}
+
+// Options controls how a region is rendered
+type Options struct {
+	Scale       int                              // Map scale level: each pixel covers 2^Scale blocks (0-4, like map items)
+	PixelSize   int                              // Output pixels per map pixel (default 1)
+	SliceY      *int                             // Cave/slice mode: start scanning columns at this Y instead of the region top
+	NoShading   bool                             // Disable height shading
+	Registry    *data.Registry                   // Registry for block map colors (default data.DefaultRegistry)
+	Entities    EntitySource                     // Entities to overlay (optional)
+	EntityColor func(*entity.Entity) color.Color // Overlay color per entity (default: players white, others red)
+}
+
+// column is the result of scanning one map pixel
+type column struct {
+	color  int     // Map color ID
+	height float64 // Average surface height
+	depth  int     // Water depth (for water shading)
+}
+
+// TopDown renders a region of the world as seen from above, using vanilla map colors.
+// The region's Y range bounds the column scan; unloaded chunks are transparent.
+func TopDown(w world.World, r world.Region, opts Options) image.Image {
+	reg := opts.Registry
+	if reg == nil {
+		reg = data.DefaultRegistry
+	}
+	pixel := max(opts.PixelSize, 1)
+	cell := 1 << max(min(opts.Scale, 4), 0)
+
+	top := r.Max.Y
+	if opts.SliceY != nil && *opts.SliceY < top {
+		top = *opts.SliceY
+	}
+
+	size := r.Size()
+	width := (size.X + cell - 1) / cell
+	height := (size.Z + cell - 1) / cell
+	img := image.NewRGBA(image.Rect(0, 0, width*pixel, height*pixel))
+
+	scan := func(px, pz int) (column, bool) {
+		return scanCell(w, reg, r.Min.X+px*cell, r.Min.Z+pz*cell, cell, top, r.Min.Y)
+	}
+
+	for px := 0; px < width; px++ {
+		// Shading compares each pixel to the last drawn one north of it, so start one
+		// row above; gaps keep the previous surface and a missing one shades flat
+		prev, hasPrev := scan(px, -1)
+		hasPrev = hasPrev && prev.color != world.MapColorNone
+		for pz := 0; pz < height; pz++ {
+			col, ok := scan(px, pz)
+			if !ok || col.color == world.MapColorNone {
+				continue
+			}
+			if !hasPrev {
+				prev = col
+			}
+
+			shade := world.MapShadeNormal
+			if !opts.NoShading {
+				shade = shadeFor(col, prev, cell, px+pz)
+			}
+			fillPixel(img, px, pz, pixel, world.MapColorToRGBA(world.MapColorByte(col.color, shade)))
+			prev, hasPrev = col, true
+		}
+	}
+
+	if opts.Entities != nil {
+		drawEntities(img, r, cell, pixel, opts)
+	}
+	return img
+}
+
+// WritePNG renders a region and encodes it as PNG
+func WritePNG(out io.Writer, w world.World, r world.Region, opts Options) error {
+	return png.Encode(out, TopDown(w, r, opts))
+}
+
+// scanCell finds the most common surface color and average height of a cell x cell area
+func scanCell(w world.World, reg *data.Registry, x0, z0, cell, top, bottom int) (column, bool) {
+	counts := make(map[int]int)
+	var totalHeight, totalDepth, samples int
+
+	for dx := 0; dx < cell; dx++ {
+		for dz := 0; dz < cell; dz++ {
+			x, z := x0+dx, z0+dz
+			if !w.IsChunkLoaded(x>>4, z>>4) {
+				continue
+			}
+			colorID, y, depth := scanColumn(w, reg, x, z, top, bottom)
+			counts[colorID]++
+			totalHeight += y
+			totalDepth += depth
+			samples++
+		}
+	}
+	if samples == 0 {
+		return column{}, false
+	}
+
+	best, bestCount := world.MapColorNone, 0
+	for colorID, count := range counts {
+		if count > bestCount || (count == bestCount && colorID > best) {
+			best, bestCount = colorID, count
+		}
+	}
+	return column{
+		color:  best,
+		height: float64(totalHeight) / float64(samples),
+		depth:  totalDepth / (cell * cell),
+	}, true
+}
+
+// scanColumn returns the map color, height and water depth of the first visible block below top
+func scanColumn(w world.World, reg *data.Registry, x, z, top, bottom int) (int, int, int) {
+	for y := top; y >= bottom; y-- {
+		colorID := mapColorAt(w, reg, world.Position{X: x, Y: y, Z: z})
+		if colorID == world.MapColorNone {
+			continue
+		}
+		if colorID != world.MapColorWater {
+			return colorID, y, 0
+		}
+
+		depth := 1
+		for y2 := y - 1; y2 >= bottom && mapColorAt(w, reg, world.Position{X: x, Y: y2, Z: z}) == world.MapColorWater; y2-- {
+			depth++
+		}
+		return colorID, y, depth
+	}
+	return world.MapColorNone, bottom, 0
+}
+
+func mapColorAt(w world.World, reg *data.Registry, pos world.Position) int {
+	block, err := w.GetBlock(pos)
+	if err != nil || block.IsAir() {
+		return world.MapColorNone
+	}
+	if info, ok := reg.GetBlock(block.ID); ok {
+		return info.MapColor
+	}
+	return world.MapColorNone
+}
+
+// shadeFor applies the vanilla map height shading rules
+func shadeFor(col, prev column, cell, parity int) world.MapShade {
+	dither := float64(parity & 1)
+
+	if col.color == world.MapColorWater {
+		f := float64(col.depth)*0.1 + dither*0.2
+		switch {
+		case f < 0.5:
+			return world.MapShadeHigh
+		case f > 0.9:
+			return world.MapShadeLow
+		}
+		return world.MapShadeNormal
+	}
+
+	f := (col.height-prev.height)*4.0/float64(cell+4) + (dither-0.5)*0.4
+	switch {
+	case f > 0.6:
+		return world.MapShadeHigh
+	case f < -0.6:
+		return world.MapShadeLow
+	}
+	return world.MapShadeNormal
+}
+
+func fillPixel(img *image.RGBA, px, pz, pixel int, c color.RGBA) {
+	for i := 0; i < pixel; i++ {
+		for j := 0; j < pixel; j++ {
+			img.SetRGBA(px*pixel+i, pz*pixel+j, c)
+		}
+	}
+}
+
+// drawEntities draws a 3x3 marker (scaled by PixelSize) for each entity inside the region
+func drawEntities(img *image.RGBA, r world.Region, cell, pixel int, opts Options) {
+	entityColor := opts.EntityColor
+	if entityColor == nil {
+		entityColor = defaultEntityColor
+	}
+
+	bounds := img.Bounds()
+	for _, e := range opts.Entities.All() {
+		pos := e.Position.ToPosition()
+		if pos.X < r.Min.X || pos.X > r.Max.X || pos.Z < r.Min.Z || pos.Z > r.Max.Z {
+			continue
+		}
+
+		cx := int((e.Position.X - float64(r.Min.X)) / float64(cell) * float64(pixel))
+		cz := int((e.Position.Z - float64(r.Min.Z)) / float64(cell) * float64(pixel))
+		c := entityColor(e)
+		for dx := -pixel; dx <= pixel; dx++ {
+			for dz := -pixel; dz <= pixel; dz++ {
+				if image.Pt(cx+dx, cz+dz).In(bounds) {
+					img.Set(cx+dx, cz+dz, c)
+				}
+			}
+		}
+	}
+}
+
+func defaultEntityColor(e *entity.Entity) color.Color {
+	if e.IsPlayer() {
+		return color.RGBA{255, 255, 255, 255}
+	}
+	return color.RGBA{255, 0, 0, 255}
+}
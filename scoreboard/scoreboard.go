@@ -0,0 +1,246 @@
+// Package scoreboard models objectives, scores, display slots and teams
+package scoreboard
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/konjacbot/prismarine-go/chat"
+)
+
+var (
+	ErrUnknownObjective = errors.New("unknown objective")
+	ErrUnknownTeam      = errors.New("unknown team")
+)
+
+// RenderType is how an objective's scores are drawn in the player list
+type RenderType string
+
+const (
+	RenderInteger RenderType = "integer"
+	RenderHearts  RenderType = "hearts"
+)
+
+// DisplaySlot is where an objective is shown
+type DisplaySlot int
+
+const (
+	SlotList      DisplaySlot = 0 // Player list (tab)
+	SlotSidebar   DisplaySlot = 1 // Sidebar
+	SlotBelowName DisplaySlot = 2 // Below player name tags
+	// Slots 3-18 are sidebars shown only to members of a team with color 0-15
+	slotTeamSidebar DisplaySlot = 3
+)
+
+// teamColors lists the team colors in display slot order
+var teamColors = []string{
+	chat.ColorBlack, chat.ColorDarkBlue, chat.ColorDarkGreen, chat.ColorDarkAqua,
+	chat.ColorDarkRed, chat.ColorDarkPurple, chat.ColorGold, chat.ColorGray,
+	chat.ColorDarkGray, chat.ColorBlue, chat.ColorGreen, chat.ColorAqua,
+	chat.ColorRed, chat.ColorLightPurple, chat.ColorYellow, chat.ColorWhite,
+}
+
+// TeamSidebarSlot returns the sidebar slot shown to teams of a color
+func TeamSidebarSlot(color string) (DisplaySlot, bool) {
+	for i, c := range teamColors {
+		if c == color {
+			return slotTeamSidebar + DisplaySlot(i), true
+		}
+	}
+	return 0, false
+}
+
+// NumberFormatKind selects how a score value is shown
+type NumberFormatKind int
+
+const (
+	FormatStyled NumberFormatKind = iota // The number with a style
+	FormatBlank                          // Nothing
+	FormatFixed                          // A fixed component instead of the number
+)
+
+// NumberFormat is how a score value is shown in the sidebar and player list
+type NumberFormat struct {
+	Kind  NumberFormatKind
+	Style chat.Style    // Style of the number (FormatStyled)
+	Fixed *chat.Message // Text shown instead (FormatFixed)
+}
+
+// DefaultNumberFormat is used when neither the score nor the objective sets a format
+var DefaultNumberFormat = NumberFormat{Kind: FormatStyled, Style: chat.Style{Color: chat.ColorRed}}
+
+// Objective is a named score counter
+type Objective struct {
+	Name         string        // Objective name
+	Criteria     string        // Criteria (e.g. "dummy"; not sent to clients)
+	DisplayName  *chat.Message // Title shown in display slots
+	RenderType   RenderType    // Integer or hearts
+	NumberFormat *NumberFormat // Default format of its scores (nil for DefaultNumberFormat)
+}
+
+// Score is the value of an objective for a score holder
+type Score struct {
+	Holder       string        // Player name or entity UUID
+	Objective    string        // Objective name
+	Value        int           // Score value
+	DisplayName  *chat.Message // Name shown instead of the holder (nil if none)
+	NumberFormat *NumberFormat // Format override (nil uses the objective's)
+}
+
+// Scoreboard holds objectives, scores, display slots and teams
+type Scoreboard struct {
+	objectives map[string]*Objective
+	scores     map[string]map[string]*Score // Objective -> holder -> score
+	display    map[DisplaySlot]string
+	teams      map[string]*Team
+	memberOf   map[string]string // Holder -> team name
+	mu         sync.RWMutex
+}
+
+// New creates an empty scoreboard
+func New() *Scoreboard {
+	return &Scoreboard{
+		objectives: make(map[string]*Objective),
+		scores:     make(map[string]map[string]*Score),
+		display:    make(map[DisplaySlot]string),
+		teams:      make(map[string]*Team),
+		memberOf:   make(map[string]string),
+	}
+}
+
+// SetObjective creates or updates an objective (its scores are kept)
+func (s *Scoreboard) SetObjective(obj Objective) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objectives[obj.Name] = &obj
+	if s.scores[obj.Name] == nil {
+		s.scores[obj.Name] = make(map[string]*Score)
+	}
+}
+
+// RemoveObjective removes an objective with its scores and display slots
+func (s *Scoreboard) RemoveObjective(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.objectives, name)
+	delete(s.scores, name)
+	for slot, objective := range s.display {
+		if objective == name {
+			delete(s.display, slot)
+		}
+	}
+}
+
+// Objective returns a copy of an objective
+func (s *Scoreboard) Objective(name string) (Objective, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	obj, ok := s.objectives[name]
+	if !ok {
+		return Objective{}, false
+	}
+	return *obj, true
+}
+
+// Objectives returns copies of all objectives sorted by name
+func (s *Scoreboard) Objectives() []Objective {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	objectives := make([]Objective, 0, len(s.objectives))
+	for _, obj := range s.objectives {
+		objectives = append(objectives, *obj)
+	}
+	sort.Slice(objectives, func(i, j int) bool { return objectives[i].Name < objectives[j].Name })
+	return objectives
+}
+
+// SetDisplay shows an objective in a display slot (empty name clears the slot)
+func (s *Scoreboard) SetDisplay(slot DisplaySlot, objective string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if objective == "" {
+		delete(s.display, slot)
+		return
+	}
+	s.display[slot] = objective
+}
+
+// Displayed returns the objective shown in a display slot
+func (s *Scoreboard) Displayed(slot DisplaySlot) (Objective, bool) {
+	s.mu.RLock()
+	name, ok := s.display[slot]
+	s.mu.RUnlock()
+	if !ok {
+		return Objective{}, false
+	}
+	return s.Objective(name)
+}
+
+// SetScore sets a holder's score; display name and format are optional overrides.
+// It returns ErrUnknownObjective if the objective does not exist.
+func (s *Scoreboard) SetScore(holder, objective string, value int, displayName *chat.Message, format *NumberFormat) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	scores, ok := s.scores[objective]
+	if !ok {
+		return ErrUnknownObjective
+	}
+	scores[holder] = &Score{
+		Holder:       holder,
+		Objective:    objective,
+		Value:        value,
+		DisplayName:  displayName,
+		NumberFormat: format,
+	}
+	return nil
+}
+
+// ResetScore removes a holder's score (from every objective if objective is empty)
+func (s *Scoreboard) ResetScore(holder, objective string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if objective != "" {
+		delete(s.scores[objective], holder)
+		return
+	}
+	for _, scores := range s.scores {
+		delete(scores, holder)
+	}
+}
+
+// Score returns a copy of a holder's score
+func (s *Scoreboard) Score(holder, objective string) (Score, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	score, ok := s.scores[objective][holder]
+	if !ok {
+		return Score{}, false
+	}
+	return *score, true
+}
+
+// Scores returns copies of an objective's scores, highest first (ties by holder name)
+func (s *Scoreboard) Scores(objective string) []Score {
+	s.mu.RLock()
+	scores := make([]Score, 0, len(s.scores[objective]))
+	for _, score := range s.scores[objective] {
+		scores = append(scores, *score)
+	}
+	s.mu.RUnlock()
+	sortScores(scores)
+	return scores
+}
+
+// HolderScores returns a holder's scores keyed by objective name
+func (s *Scoreboard) HolderScores(holder string) map[string]int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	values := make(map[string]int)
+	for objective, scores := range s.scores {
+		if score, ok := scores[holder]; ok {
+			values[objective] = score.Value
+		}
+	}
+	return values
+}
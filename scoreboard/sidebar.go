@@ -0,0 +1,102 @@
+package scoreboard
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/konjacbot/prismarine-go/chat"
+)
+
+// MaxSidebarLines is the number of scores the sidebar shows
+const MaxSidebarLines = 15
+
+// SidebarLine is a rendered sidebar row
+type SidebarLine struct {
+	Holder string       // Score holder
+	Value  int          // Score value
+	Name   chat.Message // Holder name with team decoration
+	Score  chat.Message // Formatted score (empty for the blank format)
+}
+
+// sortScores orders scores as the sidebar does: highest first, then by holder
+// name (case-insensitive)
+func sortScores(scores []Score) {
+	sort.Slice(scores, func(i, j int) bool {
+		if scores[i].Value != scores[j].Value {
+			return scores[i].Value > scores[j].Value
+		}
+		return strings.ToLower(scores[i].Holder) < strings.ToLower(scores[j].Holder)
+	})
+}
+
+// FormatValue renders a score value with a number format (nil for the default)
+func FormatValue(value int, format *NumberFormat) chat.Message {
+	if format == nil {
+		format = &DefaultNumberFormat
+	}
+	switch format.Kind {
+	case FormatBlank:
+		return chat.Message{}
+	case FormatFixed:
+		if format.Fixed != nil {
+			return *format.Fixed
+		}
+		return chat.Message{}
+	}
+	c := chat.Component{Text: strconv.Itoa(value)}
+	c.ApplyStyle(format.Style)
+	return chat.Message{Component: c}
+}
+
+// Sidebar renders the sidebar seen by a viewer (a player name): the sidebar of
+// the viewer's team color if one is set, otherwise the main sidebar. It returns
+// the objective title and up to MaxSidebarLines rows in display order; holders
+// starting with "#" are hidden. It returns false if no sidebar is shown.
+func (s *Scoreboard) Sidebar(viewer string) (*chat.Message, []SidebarLine, bool) {
+	obj, ok := Objective{}, false
+	if team, inTeam := s.TeamOf(viewer); inTeam {
+		if slot, colored := TeamSidebarSlot(team.Color); colored {
+			obj, ok = s.Displayed(slot)
+		}
+	}
+	if !ok {
+		obj, ok = s.Displayed(SlotSidebar)
+	}
+	if !ok {
+		return nil, nil, false
+	}
+
+	title := obj.DisplayName
+	if title == nil {
+		title = chat.NewMessage(obj.Name)
+	}
+	var lines []SidebarLine
+	for _, score := range s.Scores(obj.Name) {
+		if strings.HasPrefix(score.Holder, "#") {
+			continue
+		}
+		if len(lines) == MaxSidebarLines {
+			break
+		}
+		name := chat.Component{Text: score.Holder}
+		if score.DisplayName != nil {
+			name = score.DisplayName.Component
+		}
+		var team *Team
+		if t, ok := s.TeamOf(score.Holder); ok {
+			team = &t
+		}
+		format := score.NumberFormat
+		if format == nil {
+			format = obj.NumberFormat
+		}
+		lines = append(lines, SidebarLine{
+			Holder: score.Holder,
+			Value:  score.Value,
+			Name:   FormatName(team, name),
+			Score:  FormatValue(score.Value, format),
+		})
+	}
+	return title, lines, true
+}
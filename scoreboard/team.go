@@ -0,0 +1,193 @@
+package scoreboard
+
+import (
+	"sort"
+
+	"github.com/konjacbot/prismarine-go/chat"
+)
+
+// Name tag visibility rules
+const (
+	VisibilityAlways            = "always"
+	VisibilityNever             = "never"
+	VisibilityHideForOtherTeams = "hideForOtherTeams"
+	VisibilityHideForOwnTeam    = "hideForOwnTeam"
+)
+
+// Collision rules
+const (
+	CollisionAlways         = "always"
+	CollisionNever          = "never"
+	CollisionPushOtherTeams = "pushOtherTeams"
+	CollisionPushOwnTeam    = "pushOwnTeam"
+)
+
+// Team groups score holders with shared name formatting and rules
+type Team struct {
+	Name                  string        // Team name
+	DisplayName           *chat.Message // Display name
+	Prefix                *chat.Message // Shown before member names (nil if none)
+	Suffix                *chat.Message // Shown after member names (nil if none)
+	Color                 string        // Member name color (chat.Color*, empty for reset)
+	FriendlyFire          bool          // Members can hurt each other
+	SeeFriendlyInvisibles bool          // Members see invisible teammates
+	NameTagVisibility     string        // Visibility* rule
+	CollisionRule         string        // Collision* rule
+	Members               []string      // Member holders, sorted (set by the scoreboard)
+}
+
+// SetTeam creates a team or updates its settings; Members is ignored, use
+// AddMembers and RemoveMembers to change membership
+func (s *Scoreboard) SetTeam(team Team) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.teams[team.Name]; ok {
+		team.Members = old.Members
+	} else {
+		team.Members = nil
+	}
+	s.teams[team.Name] = &team
+}
+
+// RemoveTeam removes a team and its memberships
+func (s *Scoreboard) RemoveTeam(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team, ok := s.teams[name]
+	if !ok {
+		return
+	}
+	for _, member := range team.Members {
+		delete(s.memberOf, member)
+	}
+	delete(s.teams, name)
+}
+
+// AddMembers adds holders to a team, moving them out of their previous team
+func (s *Scoreboard) AddMembers(name string, holders ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team, ok := s.teams[name]
+	if !ok {
+		return ErrUnknownTeam
+	}
+	for _, holder := range holders {
+		if previous, ok := s.memberOf[holder]; ok {
+			if previous == name {
+				continue
+			}
+			s.teams[previous].Members = without(s.teams[previous].Members, holder)
+		}
+		s.memberOf[holder] = name
+		team.Members = append(team.Members, holder)
+	}
+	sort.Strings(team.Members)
+	return nil
+}
+
+// RemoveMembers removes holders from a team
+func (s *Scoreboard) RemoveMembers(name string, holders ...string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	team, ok := s.teams[name]
+	if !ok {
+		return ErrUnknownTeam
+	}
+	for _, holder := range holders {
+		if s.memberOf[holder] == name {
+			delete(s.memberOf, holder)
+			team.Members = without(team.Members, holder)
+		}
+	}
+	return nil
+}
+
+// without returns members without holder
+func without(members []string, holder string) []string {
+	out := members[:0]
+	for _, m := range members {
+		if m != holder {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// Team returns a copy of a team
+func (s *Scoreboard) Team(name string) (Team, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	team, ok := s.teams[name]
+	if !ok {
+		return Team{}, false
+	}
+	return copyTeam(team), true
+}
+
+// Teams returns copies of all teams sorted by name
+func (s *Scoreboard) Teams() []Team {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	teams := make([]Team, 0, len(s.teams))
+	for _, team := range s.teams {
+		teams = append(teams, copyTeam(team))
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Name < teams[j].Name })
+	return teams
+}
+
+// TeamOf returns the team of a holder
+func (s *Scoreboard) TeamOf(holder string) (Team, bool) {
+	s.mu.RLock()
+	name, ok := s.memberOf[holder]
+	s.mu.RUnlock()
+	if !ok {
+		return Team{}, false
+	}
+	return s.Team(name)
+}
+
+// SameTeam checks if two holders are on the same team
+func (s *Scoreboard) SameTeam(a, b string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	team, ok := s.memberOf[a]
+	return ok && s.memberOf[b] == team
+}
+
+// CanHurt checks if an attacker can damage a target: teammates only hurt each
+// other when the team allows friendly fire
+func (s *Scoreboard) CanHurt(attacker, target string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	team, ok := s.memberOf[attacker]
+	if !ok || s.memberOf[target] != team {
+		return true
+	}
+	return s.teams[team].FriendlyFire
+}
+
+// copyTeam copies a team with its own member slice
+func copyTeam(team *Team) Team {
+	t := *team
+	t.Members = append([]string(nil), team.Members...)
+	return t
+}
+
+// FormatName decorates a holder's name with the team prefix, color and suffix
+// (the name is returned unchanged if team is nil)
+func FormatName(team *Team, name chat.Component) chat.Message {
+	if team == nil {
+		return chat.Message{Component: name}
+	}
+	// The team color styles the whole line, so uncolored prefixes and suffixes inherit it
+	root := chat.Component{Color: team.Color}
+	if team.Prefix != nil {
+		root.Extra = append(root.Extra, team.Prefix.Component)
+	}
+	root.Extra = append(root.Extra, name)
+	if team.Suffix != nil {
+		root.Extra = append(root.Extra, team.Suffix.Component)
+	}
+	return chat.Message{Component: root}
+}
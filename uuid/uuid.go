@@ -0,0 +1,168 @@
+// Package uuid provides the UUID type shared by entities, profiles and the player list
+package uuid
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var ErrInvalidUUID = errors.New("invalid UUID")
+
+// UUID is a 128-bit universally unique identifier
+type UUID [16]byte
+
+// Nil is the all-zero UUID
+var Nil UUID
+
+// Parse parses a UUID with or without dashes (case-insensitive)
+func Parse(s string) (UUID, error) {
+	var u UUID
+	switch len(s) {
+	case 36:
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return Nil, ErrInvalidUUID
+		}
+		s = strings.ReplaceAll(s, "-", "")
+		if len(s) != 32 {
+			return Nil, ErrInvalidUUID
+		}
+	case 32:
+	default:
+		return Nil, ErrInvalidUUID
+	}
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return Nil, ErrInvalidUUID
+	}
+	return u, nil
+}
+
+// MustParse parses a UUID and panics if it is invalid
+func MustParse(s string) UUID {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// OfflinePlayer returns the UUID an offline-mode server gives a player name
+// (version 3: MD5 of "OfflinePlayer:<name>")
+func OfflinePlayer(name string) UUID {
+	u := UUID(md5.Sum([]byte("OfflinePlayer:" + name)))
+	u[6] = u[6]&0x0f | 0x30 // Version 3
+	u[8] = u[8]&0x3f | 0x80 // RFC 4122 variant
+	return u
+}
+
+// FromLongs builds a UUID from its most and least significant halves
+// (the protocol and legacy NBT form)
+func FromLongs(most, least int64) UUID {
+	var u UUID
+	for i := 0; i < 8; i++ {
+		u[i] = byte(uint64(most) >> (56 - 8*i))
+		u[8+i] = byte(uint64(least) >> (56 - 8*i))
+	}
+	return u
+}
+
+// Longs returns the most and least significant halves of the UUID
+func (u UUID) Longs() (most, least int64) {
+	for i := 0; i < 8; i++ {
+		most = most<<8 | int64(u[i])
+		least = least<<8 | int64(u[8+i])
+	}
+	return most, least
+}
+
+// FromIntArray builds a UUID from the four big-endian ints used in NBT
+func FromIntArray(a [4]int32) UUID {
+	var u UUID
+	for i, v := range a {
+		u[4*i] = byte(uint32(v) >> 24)
+		u[4*i+1] = byte(uint32(v) >> 16)
+		u[4*i+2] = byte(uint32(v) >> 8)
+		u[4*i+3] = byte(uint32(v))
+	}
+	return u
+}
+
+// IntArray returns the UUID as four big-endian ints (the NBT form)
+func (u UUID) IntArray() [4]int32 {
+	var a [4]int32
+	for i := range a {
+		a[i] = int32(uint32(u[4*i])<<24 | uint32(u[4*i+1])<<16 | uint32(u[4*i+2])<<8 | uint32(u[4*i+3]))
+	}
+	return a
+}
+
+// String formats the UUID with dashes (e.g. "069a79f4-44e9-4726-a5be-fca90e38aaf5")
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// Undashed formats the UUID without dashes, as the Mojang API does
+func (u UUID) Undashed() string {
+	return hex.EncodeToString(u[:])
+}
+
+// Version returns the UUID version (3 for offline players, 4 for online accounts)
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// IsNil returns true for the all-zero UUID
+func (u UUID) IsNil() bool {
+	return u == Nil
+}
+
+// MarshalText formats the UUID with dashes
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText parses a UUID with or without dashes
+func (u *UUID) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+// MarshalJSON encodes the UUID as a dashed string
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(u.String())
+}
+
+// UnmarshalJSON decodes a UUID string (with or without dashes) or the
+// four-int array form
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		var ints []int32
+		if err := json.Unmarshal(trimmed, &ints); err != nil || len(ints) != 4 {
+			return ErrInvalidUUID
+		}
+		*u = FromIntArray([4]int32(ints))
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return ErrInvalidUUID
+	}
+	return u.UnmarshalText([]byte(s))
+}
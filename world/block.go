@@ -14,8 +14,11 @@ func (b *Block) IsAir() bool {
 
 // BlockInfo contains additional metadata about a block type
 type BlockInfo struct {
-	ID       int     // Block ID
-	Name     string  // Block name
-	Solid    bool    // Whether the block is solid
-	Hardness float64 // Mining hardness
+	ID           int     // Block ID
+	Name         string  // Block name
+	Solid        bool    // Whether the block is solid
+	Hardness     float64 // Mining hardness (-1 if unbreakable)
+	RequiresTool bool    // Drops only when mined with a correct tool
+	Resistance   float64 // Blast resistance
+	MapColor     int     // Map color ID (0 if not drawn on maps)
 }
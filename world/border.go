@@ -0,0 +1,205 @@
+package world
+
+import (
+	"math"
+	"sync"
+)
+
+// Vanilla world border defaults
+const (
+	DefaultBorderSize          = 59999968
+	DefaultBorderWarningBlocks = 5
+	DefaultBorderWarningTime   = 15
+	MaxBorderCoordinate        = 29999984
+)
+
+// BorderStatus tells whether the border is moving
+type BorderStatus int
+
+const (
+	BorderStationary BorderStatus = iota
+	BorderGrowing
+	BorderShrinking
+)
+
+// WorldBorder is the square border around the world. The size moves linearly
+// towards a target over a number of ticks, advanced by Tick.
+type WorldBorder struct {
+	centerX, centerZ float64
+	from, to         float64 // Diameter at the start and end of the move
+	lerpTicks        int64   // Length of the move
+	lerpElapsed      int64   // Ticks passed since the move began
+	warningBlocks    int
+	warningTime      int // Seconds
+	mu               sync.RWMutex
+}
+
+// NewWorldBorder creates a border with the vanilla defaults
+func NewWorldBorder() *WorldBorder {
+	return &WorldBorder{
+		from:          DefaultBorderSize,
+		to:            DefaultBorderSize,
+		warningBlocks: DefaultBorderWarningBlocks,
+		warningTime:   DefaultBorderWarningTime,
+	}
+}
+
+// Initialize applies a full border update from the server (speed in milliseconds)
+func (b *WorldBorder) Initialize(x, z, oldSize, newSize float64, speed int64, warningBlocks, warningTime int) {
+	b.SetCenter(x, z)
+	b.LerpSize(oldSize, newSize, speed)
+	b.SetWarning(warningBlocks, warningTime)
+}
+
+// SetCenter moves the border center
+func (b *WorldBorder) SetCenter(x, z float64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.centerX, b.centerZ = x, z
+}
+
+// Center returns the border center
+func (b *WorldBorder) Center() (x, z float64) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.centerX, b.centerZ
+}
+
+// SetSize sets the diameter immediately
+func (b *WorldBorder) SetSize(size float64) {
+	b.LerpSize(size, size, 0)
+}
+
+// LerpSize moves the diameter from one size to another over speed milliseconds
+func (b *WorldBorder) LerpSize(from, to float64, speed int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.from, b.to = from, to
+	b.lerpTicks = speed / 50
+	b.lerpElapsed = 0
+	if b.lerpTicks <= 0 {
+		b.from = to
+	}
+}
+
+// SetWarning sets the warning distance in blocks and time in seconds
+func (b *WorldBorder) SetWarning(blocks, seconds int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.warningBlocks, b.warningTime = blocks, seconds
+}
+
+// Warning returns the warning distance in blocks and time in seconds
+func (b *WorldBorder) Warning() (blocks, seconds int) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.warningBlocks, b.warningTime
+}
+
+// Tick advances a moving border by one tick
+func (b *WorldBorder) Tick() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.lerpTicks <= 0 {
+		return
+	}
+	b.lerpElapsed++
+	if b.lerpElapsed >= b.lerpTicks {
+		b.from, b.lerpTicks, b.lerpElapsed = b.to, 0, 0
+	}
+}
+
+// Size returns the current diameter
+func (b *WorldBorder) Size() float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.size()
+}
+
+// TargetSize returns the diameter the border is moving towards
+func (b *WorldBorder) TargetSize() float64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.to
+}
+
+// Status returns whether the border is growing, shrinking or stationary
+func (b *WorldBorder) Status() BorderStatus {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	switch {
+	case b.lerpTicks <= 0 || b.from == b.to:
+		return BorderStationary
+	case b.to > b.from:
+		return BorderGrowing
+	}
+	return BorderShrinking
+}
+
+// RemainingTicks returns the ticks until a moving border stops
+func (b *WorldBorder) RemainingTicks() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lerpTicks - b.lerpElapsed
+}
+
+// Bounds returns the current edges of the border (clamped to the world limit)
+func (b *WorldBorder) Bounds() (minX, minZ, maxX, maxZ float64) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.bounds()
+}
+
+// Contains checks if a point lies inside the border
+func (b *WorldBorder) Contains(pos Vec3d) bool {
+	minX, minZ, maxX, maxZ := b.Bounds()
+	return pos.X >= minX && pos.X < maxX && pos.Z >= minZ && pos.Z < maxZ
+}
+
+// ContainsBlock checks if any part of a block lies inside the border
+func (b *WorldBorder) ContainsBlock(pos Position) bool {
+	minX, minZ, maxX, maxZ := b.Bounds()
+	x, z := float64(pos.X), float64(pos.Z)
+	return x+1 > minX && x < maxX && z+1 > minZ && z < maxZ
+}
+
+// DistanceToBorder returns the distance from a point to the nearest edge,
+// negative when the point is outside
+func (b *WorldBorder) DistanceToBorder(pos Vec3d) float64 {
+	minX, minZ, maxX, maxZ := b.Bounds()
+	return min(pos.X-minX, maxX-pos.X, pos.Z-minZ, maxZ-pos.Z)
+}
+
+// InWarning reports whether a point is close enough to the border for the red
+// warning overlay: within the warning distance, or reached by a shrinking border
+// within the warning time
+func (b *WorldBorder) InWarning(pos Vec3d) bool {
+	dist := b.DistanceToBorder(pos)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	limit := float64(b.warningBlocks)
+	if b.lerpTicks > 0 && b.to < b.from {
+		perTick := (b.from - b.to) / float64(b.lerpTicks)
+		limit = max(limit, min(perTick*float64(b.warningTime)*20, b.size()-b.to))
+	}
+	return dist < limit
+}
+
+// size returns the current diameter (caller holds the lock)
+func (b *WorldBorder) size() float64 {
+	if b.lerpTicks <= 0 {
+		return b.to
+	}
+	progress := float64(b.lerpElapsed) / float64(b.lerpTicks)
+	return b.from + (b.to-b.from)*progress
+}
+
+// bounds returns the current edges (caller holds the lock)
+func (b *WorldBorder) bounds() (minX, minZ, maxX, maxZ float64) {
+	half := b.size() / 2
+	clampCoord := func(v float64) float64 {
+		return math.Max(-MaxBorderCoordinate, math.Min(MaxBorderCoordinate, v))
+	}
+	return clampCoord(b.centerX - half), clampCoord(b.centerZ - half),
+		clampCoord(b.centerX + half), clampCoord(b.centerZ + half)
+}
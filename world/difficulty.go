@@ -0,0 +1,26 @@
+package world
+
+// Difficulty represents the world difficulty
+type Difficulty int
+
+const (
+	DifficultyPeaceful Difficulty = 0
+	DifficultyEasy     Difficulty = 1
+	DifficultyNormal   Difficulty = 2
+	DifficultyHard     Difficulty = 3
+)
+
+// String returns the difficulty name
+func (d Difficulty) String() string {
+	switch d {
+	case DifficultyPeaceful:
+		return "peaceful"
+	case DifficultyEasy:
+		return "easy"
+	case DifficultyNormal:
+		return "normal"
+	case DifficultyHard:
+		return "hard"
+	}
+	return "unknown"
+}
@@ -0,0 +1,171 @@
+package world
+
+import "image/color"
+
+// Map color IDs (base colors used by in-game maps)
+const (
+	MapColorNone = iota
+	MapColorGrass
+	MapColorSand
+	MapColorWool
+	MapColorFire
+	MapColorIce
+	MapColorMetal
+	MapColorPlant
+	MapColorSnow
+	MapColorClay
+	MapColorDirt
+	MapColorStone
+	MapColorWater
+	MapColorWood
+	MapColorQuartz
+	MapColorOrange
+	MapColorMagenta
+	MapColorLightBlue
+	MapColorYellow
+	MapColorLightGreen
+	MapColorPink
+	MapColorGray
+	MapColorLightGray
+	MapColorCyan
+	MapColorPurple
+	MapColorBlue
+	MapColorBrown
+	MapColorGreen
+	MapColorRed
+	MapColorBlack
+	MapColorGold
+	MapColorDiamond
+	MapColorLapis
+	MapColorEmerald
+	MapColorPodzol
+	MapColorNether
+	MapColorTerracottaWhite
+	MapColorTerracottaOrange
+	MapColorTerracottaMagenta
+	MapColorTerracottaLightBlue
+	MapColorTerracottaYellow
+	MapColorTerracottaLightGreen
+	MapColorTerracottaPink
+	MapColorTerracottaGray
+	MapColorTerracottaLightGray
+	MapColorTerracottaCyan
+	MapColorTerracottaPurple
+	MapColorTerracottaBlue
+	MapColorTerracottaBrown
+	MapColorTerracottaGreen
+	MapColorTerracottaRed
+	MapColorTerracottaBlack
+	MapColorCrimsonNylium
+	MapColorCrimsonStem
+	MapColorCrimsonHyphae
+	MapColorWarpedNylium
+	MapColorWarpedStem
+	MapColorWarpedHyphae
+	MapColorWarpedWartBlock
+	MapColorDeepslate
+	MapColorRawIron
+	MapColorGlowLichen
+)
+
+// MapShade is the brightness variant of a map color
+type MapShade int
+
+// Map shades in the order they appear in map color bytes
+const (
+	MapShadeLow    MapShade = iota // x180/255
+	MapShadeNormal                 // x220/255
+	MapShadeHigh                   // x255/255
+	MapShadeLowest                 // x135/255 (only produced by map editing, not terrain)
+)
+
+// mapShadeMultipliers stores the brightness multiplier of each shade
+var mapShadeMultipliers = [4]uint32{180, 220, 255, 135}
+
+// MapColorRGB stores the base RGB value of each map color ID
+var MapColorRGB = []color.RGBA{
+	{0, 0, 0, 0},
+	{127, 178, 56, 255},
+	{247, 233, 163, 255},
+	{199, 199, 199, 255},
+	{255, 0, 0, 255},
+	{160, 160, 255, 255},
+	{167, 167, 167, 255},
+	{0, 124, 0, 255},
+	{255, 255, 255, 255},
+	{164, 168, 184, 255},
+	{151, 109, 77, 255},
+	{112, 112, 112, 255},
+	{64, 64, 255, 255},
+	{143, 119, 72, 255},
+	{255, 252, 245, 255},
+	{216, 127, 51, 255},
+	{178, 76, 216, 255},
+	{102, 153, 216, 255},
+	{229, 229, 51, 255},
+	{127, 204, 25, 255},
+	{242, 127, 165, 255},
+	{76, 76, 76, 255},
+	{153, 153, 153, 255},
+	{76, 127, 153, 255},
+	{127, 63, 178, 255},
+	{51, 76, 178, 255},
+	{102, 76, 51, 255},
+	{102, 127, 51, 255},
+	{153, 51, 51, 255},
+	{25, 25, 25, 255},
+	{250, 238, 77, 255},
+	{92, 219, 213, 255},
+	{74, 128, 255, 255},
+	{0, 217, 58, 255},
+	{129, 86, 49, 255},
+	{112, 2, 0, 255},
+	{209, 177, 161, 255},
+	{159, 82, 36, 255},
+	{149, 87, 108, 255},
+	{112, 108, 138, 255},
+	{186, 133, 36, 255},
+	{103, 117, 53, 255},
+	{160, 77, 78, 255},
+	{57, 41, 35, 255},
+	{135, 107, 98, 255},
+	{87, 92, 92, 255},
+	{122, 73, 88, 255},
+	{76, 62, 92, 255},
+	{76, 50, 35, 255},
+	{76, 82, 42, 255},
+	{142, 60, 46, 255},
+	{37, 22, 16, 255},
+	{189, 48, 49, 255},
+	{148, 63, 97, 255},
+	{92, 25, 29, 255},
+	{22, 126, 134, 255},
+	{58, 142, 140, 255},
+	{86, 44, 62, 255},
+	{20, 180, 133, 255},
+	{100, 100, 100, 255},
+	{216, 175, 147, 255},
+	{127, 167, 150, 255},
+}
+
+// MapColorByte packs a map color ID and shade into the byte format used by map items
+func MapColorByte(colorID int, shade MapShade) byte {
+	return byte(colorID*4 + int(shade))
+}
+
+// MapColorToRGBA converts a map color byte (color ID * 4 + shade) to RGBA.
+// Color ID 0 and unknown IDs are fully transparent.
+func MapColorToRGBA(b byte) color.RGBA {
+	id := int(b) / 4
+	if id == MapColorNone || id >= len(MapColorRGB) {
+		return color.RGBA{}
+	}
+	base := MapColorRGB[id]
+	mul := mapShadeMultipliers[b%4]
+	return color.RGBA{
+		R: uint8(uint32(base.R) * mul / 255),
+		G: uint8(uint32(base.G) * mul / 255),
+		B: uint8(uint32(base.B) * mul / 255),
+		A: 255,
+	}
+}
@@ -0,0 +1,173 @@
+package world
+
+import (
+	"errors"
+	"image"
+	"image/color"
+
+	"github.com/konjacbot/prismarine-go/chat"
+)
+
+// MapSize is the width and height of a map item in pixels
+const MapSize = 128
+
+var ErrMapPatchOutOfBounds = errors.New("map patch out of bounds")
+
+// MapDecorationType identifies a map icon (matches the map_decoration_type registry order)
+type MapDecorationType int
+
+const (
+	DecorationPlayer MapDecorationType = iota
+	DecorationFrame
+	DecorationRedMarker
+	DecorationBlueMarker
+	DecorationTargetX
+	DecorationTargetPoint
+	DecorationPlayerOffMap
+	DecorationPlayerOffLimits
+	DecorationMansion
+	DecorationMonument
+	DecorationBannerWhite
+	DecorationBannerOrange
+	DecorationBannerMagenta
+	DecorationBannerLightBlue
+	DecorationBannerYellow
+	DecorationBannerLime
+	DecorationBannerPink
+	DecorationBannerGray
+	DecorationBannerLightGray
+	DecorationBannerCyan
+	DecorationBannerPurple
+	DecorationBannerBlue
+	DecorationBannerBrown
+	DecorationBannerGreen
+	DecorationBannerRed
+	DecorationBannerBlack
+	DecorationRedX
+	DecorationVillageDesert
+	DecorationVillagePlains
+	DecorationVillageSavanna
+	DecorationVillageSnowy
+	DecorationVillageTaiga
+	DecorationJungleTemple
+	DecorationSwampHut
+	DecorationTrialChambers
+)
+
+// IsBanner returns true if the decoration marks a banner
+func (t MapDecorationType) IsBanner() bool {
+	return t >= DecorationBannerWhite && t <= DecorationBannerBlack
+}
+
+// IsPlayer returns true if the decoration marks a player
+func (t MapDecorationType) IsPlayer() bool {
+	return t == DecorationPlayer || t == DecorationPlayerOffMap || t == DecorationPlayerOffLimits
+}
+
+// MapDecoration is an icon drawn on a map
+type MapDecoration struct {
+	Type        MapDecorationType
+	X, Z        int8          // Position in map half-pixels (-128 to 127, 0 is the center)
+	Rotation    int8          // Rotation in 1/16 turns (0-15)
+	DisplayName *chat.Message // Optional label (e.g. named banners)
+}
+
+// PixelPos returns the decoration position in map pixel coordinates (0-127)
+func (d MapDecoration) PixelPos() (int, int) {
+	return (int(d.X) + 128) / 2, (int(d.Z) + 128) / 2
+}
+
+// MapPatch is a rectangular update of map colors
+type MapPatch struct {
+	X, Z    int    // Top-left corner of the patch
+	Columns int    // Patch width
+	Rows    int    // Patch height
+	Data    []byte // Color bytes, row by row (Columns * Rows)
+}
+
+// MapUpdate is an incremental update of a map item, as sent by the server
+type MapUpdate struct {
+	Scale       int8
+	Locked      bool
+	Decorations []MapDecoration // nil leaves the decorations unchanged
+	Patch       *MapPatch       // nil leaves the colors unchanged
+}
+
+// MapData holds the contents of a map item
+type MapData struct {
+	ID          int             // Map ID
+	Scale       int8            // Zoom level (0-4): each pixel covers 2^Scale blocks
+	Locked      bool            // Locked in a cartography table
+	Decorations []MapDecoration // Icons on the map
+	Colors      []byte          // 128x128 color bytes (color ID * 4 + shade), row by row
+}
+
+// NewMapData creates a new blank map
+func NewMapData(id int) *MapData {
+	return &MapData{
+		ID:     id,
+		Colors: make([]byte, MapSize*MapSize),
+	}
+}
+
+// Apply applies an incremental map update
+func (m *MapData) Apply(update MapUpdate) error {
+	m.Scale = update.Scale
+	m.Locked = update.Locked
+	if update.Decorations != nil {
+		m.Decorations = update.Decorations
+	}
+	if update.Patch != nil {
+		return m.ApplyPatch(*update.Patch)
+	}
+	return nil
+}
+
+// ApplyPatch copies a patch of color bytes into the map
+func (m *MapData) ApplyPatch(p MapPatch) error {
+	if p.X < 0 || p.Z < 0 || p.Columns < 0 || p.Rows < 0 ||
+		p.X+p.Columns > MapSize || p.Z+p.Rows > MapSize || len(p.Data) < p.Columns*p.Rows {
+		return ErrMapPatchOutOfBounds
+	}
+
+	// Zero-value maps get their color buffer on the first patch
+	if len(m.Colors) < MapSize*MapSize {
+		colors := make([]byte, MapSize*MapSize)
+		copy(colors, m.Colors)
+		m.Colors = colors
+	}
+	for row := 0; row < p.Rows; row++ {
+		start := (p.Z+row)*MapSize + p.X
+		copy(m.Colors[start:start+p.Columns], p.Data[row*p.Columns:(row+1)*p.Columns])
+	}
+	return nil
+}
+
+// ColorAt returns the color byte at map pixel coordinates
+func (m *MapData) ColorAt(x, z int) byte {
+	if x < 0 || z < 0 || x >= MapSize || z >= MapSize || z*MapSize+x >= len(m.Colors) {
+		return 0
+	}
+	return m.Colors[z*MapSize+x]
+}
+
+// RGBAAt returns the RGBA color at map pixel coordinates
+func (m *MapData) RGBAAt(x, z int) color.RGBA {
+	return MapColorToRGBA(m.ColorAt(x, z))
+}
+
+// Image returns the map colors as a 128x128 paletted image (decorations are not drawn)
+func (m *MapData) Image() *image.Paletted {
+	img := image.NewPaletted(image.Rect(0, 0, MapSize, MapSize), MapPalette)
+	copy(img.Pix, m.Colors)
+	return img
+}
+
+// MapPalette maps every color byte to its RGBA value, for use with image.Paletted
+var MapPalette = func() color.Palette {
+	palette := make(color.Palette, 256)
+	for i := range palette {
+		palette[i] = MapColorToRGBA(byte(i))
+	}
+	return palette
+}()
@@ -0,0 +1,354 @@
+package world
+
+// World height limits used by SimpleWorld chunks (24 sections starting at Y=-64)
+const (
+	MinWorldY = -64
+	MaxWorldY = 319
+)
+
+// Region represents an inclusive cuboid of block positions
+type Region struct {
+	Min, Max Position
+}
+
+// NewRegion creates a region spanning two corner positions in any order
+func NewRegion(a, b Position) Region {
+	return Region{
+		Min: Position{X: min(a.X, b.X), Y: min(a.Y, b.Y), Z: min(a.Z, b.Z)},
+		Max: Position{X: max(a.X, b.X), Y: max(a.Y, b.Y), Z: max(a.Z, b.Z)},
+	}
+}
+
+// Size returns the number of blocks along each axis
+func (r Region) Size() Position {
+	return Position{X: r.Max.X - r.Min.X + 1, Y: r.Max.Y - r.Min.Y + 1, Z: r.Max.Z - r.Min.Z + 1}
+}
+
+// Volume returns the number of blocks in the region
+func (r Region) Volume() int {
+	size := r.Size()
+	return size.X * size.Y * size.Z
+}
+
+// Contains checks if a position lies inside the region
+func (r Region) Contains(pos Position) bool {
+	return pos.X >= r.Min.X && pos.X <= r.Max.X &&
+		pos.Y >= r.Min.Y && pos.Y <= r.Max.Y &&
+		pos.Z >= r.Min.Z && pos.Z <= r.Max.Z
+}
+
+// ForEach calls fn for every position in the region (X fastest, then Z, then Y)
+func (r Region) ForEach(fn func(pos Position)) {
+	for y := r.Min.Y; y <= r.Max.Y; y++ {
+		for z := r.Min.Z; z <= r.Max.Z; z++ {
+			for x := r.Min.X; x <= r.Max.X; x++ {
+				fn(Position{X: x, Y: y, Z: z})
+			}
+		}
+	}
+}
+
+// Mask decides whether a block is affected by a region operation
+type Mask func(block Block) bool
+
+// MatchIDs returns a mask matching blocks with any of the given IDs
+func MatchIDs(ids ...int) Mask {
+	set := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return func(block Block) bool {
+		return set[block.ID]
+	}
+}
+
+// MatchAir returns a mask matching air blocks
+func MatchAir() Mask {
+	return func(block Block) bool {
+		return block.IsAir()
+	}
+}
+
+// Not returns a mask matching every block the given mask rejects
+func Not(mask Mask) Mask {
+	return func(block Block) bool {
+		return !mask(block)
+	}
+}
+
+// EditRecord holds the changes made by a region operation and can be undone
+type EditRecord struct {
+	Changes []BlockChange
+}
+
+// Count returns the number of blocks that were changed
+func (e *EditRecord) Count() int {
+	return len(e.Changes)
+}
+
+// Inverse returns a record that reverts this edit
+func (e *EditRecord) Inverse() *EditRecord {
+	inverse := make([]BlockChange, len(e.Changes))
+	for i, change := range e.Changes {
+		inverse[len(e.Changes)-1-i] = BlockChange{Pos: change.Pos, Old: change.New, New: change.Old}
+	}
+	return &EditRecord{Changes: inverse}
+}
+
+// Rotation is a clockwise rotation around the Y axis (viewed from above)
+type Rotation int
+
+const (
+	Rotate0 Rotation = iota
+	Rotate90
+	Rotate180
+	Rotate270
+)
+
+// Mirror flips a clipboard along an axis
+type Mirror int
+
+const (
+	MirrorNone Mirror = iota
+	MirrorX           // Flip along the X axis (east <-> west)
+	MirrorZ           // Flip along the Z axis (north <-> south)
+)
+
+// Clipboard holds a copied region relative to the position it was copied from
+type Clipboard struct {
+	Offset Position // Region minimum relative to the copy origin
+	Size   Position // Region dimensions
+	Blocks []Block  // Blocks in Region.ForEach order
+}
+
+// PasteOptions controls how a clipboard is placed into the world
+type PasteOptions struct {
+	Rotation Rotation
+	Mirror   Mirror // Applied before rotation
+	SkipAir  bool   // Leave existing blocks where the clipboard has air
+}
+
+// transform maps a clipboard-relative offset to a paste-relative offset.
+// Only positions are transformed; directional block states are kept as-is.
+func (o PasteOptions) transform(p Position) Position {
+	switch o.Mirror {
+	case MirrorX:
+		p.X = -p.X
+	case MirrorZ:
+		p.Z = -p.Z
+	}
+	switch o.Rotation {
+	case Rotate90:
+		p.X, p.Z = -p.Z, p.X
+	case Rotate180:
+		p.X, p.Z = -p.X, -p.Z
+	case Rotate270:
+		p.X, p.Z = p.Z, -p.X
+	}
+	return p
+}
+
+// Fill sets every block in the region to block
+func (w *SimpleWorld) Fill(r Region, block Block) (*EditRecord, error) {
+	changes := make([]BlockChange, 0, r.Volume())
+	r.ForEach(func(pos Position) {
+		changes = append(changes, BlockChange{Pos: pos, New: block})
+	})
+	return w.applyRegion(r, changes)
+}
+
+// Replace sets every block in the region matching mask to block
+func (w *SimpleWorld) Replace(r Region, mask Mask, block Block) (*EditRecord, error) {
+	if err := checkRegion(r); err != nil {
+		return nil, err
+	}
+
+	w.mu.Lock()
+	// The lock is handed to applyLocked on success; release it on errors or a panicking mask
+	locked := true
+	defer func() {
+		if locked {
+			w.mu.Unlock()
+		}
+	}()
+
+	if err := w.checkLoadedLocked(r); err != nil {
+		return nil, err
+	}
+
+	changes := []BlockChange{}
+	r.ForEach(func(pos Position) {
+		if mask(w.blockAtLocked(pos)) {
+			changes = append(changes, BlockChange{Pos: pos, New: block})
+		}
+	})
+	locked = false
+	return w.applyLocked(changes)
+}
+
+// Count returns the number of blocks in the region matching mask
+func (w *SimpleWorld) Count(r Region, mask Mask) (int, error) {
+	if err := checkRegion(r); err != nil {
+		return 0, err
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if err := w.checkLoadedLocked(r); err != nil {
+		return 0, err
+	}
+
+	count := 0
+	r.ForEach(func(pos Position) {
+		if mask(w.blockAtLocked(pos)) {
+			count++
+		}
+	})
+	return count, nil
+}
+
+// Copy copies a region into a clipboard, relative to origin
+func (w *SimpleWorld) Copy(r Region, origin Position) (*Clipboard, error) {
+	if err := checkRegion(r); err != nil {
+		return nil, err
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if err := w.checkLoadedLocked(r); err != nil {
+		return nil, err
+	}
+
+	clip := &Clipboard{
+		Offset: Position{X: r.Min.X - origin.X, Y: r.Min.Y - origin.Y, Z: r.Min.Z - origin.Z},
+		Size:   r.Size(),
+		Blocks: make([]Block, 0, r.Volume()),
+	}
+	r.ForEach(func(pos Position) {
+		clip.Blocks = append(clip.Blocks, w.blockAtLocked(pos))
+	})
+	return clip, nil
+}
+
+// Paste places a clipboard with its copy origin at the given position
+func (w *SimpleWorld) Paste(clip *Clipboard, at Position, opts PasteOptions) (*EditRecord, error) {
+	size := clip.Size
+	if size.X <= 0 || size.Y <= 0 || size.Z <= 0 || len(clip.Blocks) != size.X*size.Y*size.Z {
+		return nil, ErrInvalidClipboard
+	}
+
+	changes := make([]BlockChange, 0, len(clip.Blocks))
+	i := 0
+	r := Region{Min: clip.Offset, Max: clip.Offset.Add(Position{X: clip.Size.X - 1, Y: clip.Size.Y - 1, Z: clip.Size.Z - 1})}
+	r.ForEach(func(offset Position) {
+		block := clip.Blocks[i]
+		i++
+		if opts.SkipAir && block.IsAir() {
+			return
+		}
+		changes = append(changes, BlockChange{Pos: at.Add(opts.transform(offset)), New: block})
+	})
+	// Rotation and mirroring map the clipboard corners onto the destination corners
+	dest := NewRegion(at.Add(opts.transform(r.Min)), at.Add(opts.transform(r.Max)))
+	return w.applyRegion(dest, changes)
+}
+
+// Apply applies an edit record's changes, e.g. to redo an edit
+func (w *SimpleWorld) Apply(rec *EditRecord) (*EditRecord, error) {
+	changes := make([]BlockChange, len(rec.Changes))
+	for i, change := range rec.Changes {
+		changes[i] = BlockChange{Pos: change.Pos, New: change.New}
+	}
+	return w.apply(changes)
+}
+
+// Undo reverts an edit record and returns the record of the revert
+func (w *SimpleWorld) Undo(rec *EditRecord) (*EditRecord, error) {
+	return w.Apply(rec.Inverse())
+}
+
+// apply writes all planned changes under a single lock and emits one change batch
+func (w *SimpleWorld) apply(changes []BlockChange) (*EditRecord, error) {
+	for _, change := range changes {
+		if change.Pos.Y < MinWorldY || change.Pos.Y > MaxWorldY {
+			return nil, ErrBlockOutOfBounds
+		}
+	}
+
+	w.mu.Lock()
+	return w.applyLocked(changes)
+}
+
+// applyRegion applies changes lying inside r, refusing regions with unloaded chunks
+func (w *SimpleWorld) applyRegion(r Region, changes []BlockChange) (*EditRecord, error) {
+	if err := checkRegion(r); err != nil {
+		return nil, err
+	}
+
+	w.mu.Lock()
+	if err := w.checkLoadedLocked(r); err != nil {
+		w.mu.Unlock()
+		return nil, err
+	}
+	return w.applyLocked(changes)
+}
+
+// applyLocked expects w.mu to be held for writing and releases it
+func (w *SimpleWorld) applyLocked(changes []BlockChange) (*EditRecord, error) {
+	applied := changes[:0]
+	for _, change := range changes {
+		change.Old = w.blockAtLocked(change.Pos)
+		if change.Old == change.New {
+			continue
+		}
+
+		chunkPos := ChunkPos{X: change.Pos.X >> 4, Z: change.Pos.Z >> 4}
+		chunk, exists := w.chunks[chunkPos]
+		if !exists {
+			chunk = NewChunk(chunkPos.X, chunkPos.Z, 24)
+			w.chunks[chunkPos] = chunk
+		}
+		chunk.SetBlock(change.Pos.X&15, change.Pos.Y, change.Pos.Z&15, change.New)
+		applied = append(applied, change)
+	}
+	listeners := w.listeners
+	w.mu.Unlock()
+
+	if len(applied) > 0 {
+		notify(listeners, applied)
+	}
+	return &EditRecord{Changes: applied}, nil
+}
+
+// blockAtLocked returns the block at pos, or air if its chunk is not loaded
+func (w *SimpleWorld) blockAtLocked(pos Position) Block {
+	chunk, exists := w.chunks[ChunkPos{X: pos.X >> 4, Z: pos.Z >> 4}]
+	if !exists {
+		return Block{}
+	}
+	if block := chunk.GetBlock(pos.X&15, pos.Y, pos.Z&15); block != nil {
+		return *block
+	}
+	return Block{}
+}
+
+// checkLoadedLocked ensures every chunk overlapping the region is loaded
+func (w *SimpleWorld) checkLoadedLocked(r Region) error {
+	for cx := r.Min.X >> 4; cx <= r.Max.X>>4; cx++ {
+		for cz := r.Min.Z >> 4; cz <= r.Max.Z>>4; cz++ {
+			if _, exists := w.chunks[ChunkPos{X: cx, Z: cz}]; !exists {
+				return ErrChunkNotLoaded
+			}
+		}
+	}
+	return nil
+}
+
+func checkRegion(r Region) error {
+	if r.Min.Y < MinWorldY || r.Max.Y > MaxWorldY {
+		return ErrBlockOutOfBounds
+	}
+	return nil
+}
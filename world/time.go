@@ -0,0 +1,120 @@
+package world
+
+import "math"
+
+// Day cycle lengths and landmarks in ticks
+const (
+	DayTicks     = 24000
+	MoonPhases   = 8
+	TimeNoon     = 6000
+	TimeSunset   = 12000
+	TimeMidnight = 18000
+)
+
+// moonBrightness is the moon brightness of each phase (full moon first)
+var moonBrightness = [MoonPhases]float64{1, 0.75, 0.5, 0.25, 0, 0.25, 0.5, 0.75}
+
+// WorldTime is the world age and time of day sent by the server
+type WorldTime struct {
+	Age       int64 // Total ticks the world has run
+	DayTime   int64 // Time of day including elapsed days
+	Advancing bool  // False when the daylight cycle is stopped
+}
+
+// Update applies a time update from the server
+func (t *WorldTime) Update(age, dayTime int64, advancing bool) {
+	t.Age, t.DayTime, t.Advancing = age, dayTime, advancing
+}
+
+// Tick advances the clock by one tick between server updates
+func (t *WorldTime) Tick() {
+	t.Age++
+	if t.Advancing {
+		t.DayTime++
+	}
+}
+
+// TimeOfDay returns the time within the current day (0-23999, 0 is sunrise)
+func (t WorldTime) TimeOfDay() int64 {
+	return floorMod(t.DayTime, DayTicks)
+}
+
+// Day returns the number of full days elapsed
+func (t WorldTime) Day() int64 {
+	return floorDiv(t.DayTime, DayTicks)
+}
+
+// MoonPhase returns the moon phase (0 full moon, 4 new moon)
+func (t WorldTime) MoonPhase() int {
+	return int(floorMod(t.Day(), MoonPhases))
+}
+
+// MoonBrightness returns the moon brightness (1 at full moon, 0 at new moon)
+func (t WorldTime) MoonBrightness() float64 {
+	return moonBrightness[t.MoonPhase()]
+}
+
+// CelestialAngle returns the sun angle as a fraction of a turn (0 at noon, 0.5 at midnight)
+func (t WorldTime) CelestialAngle() float64 {
+	d := float64(t.TimeOfDay())/DayTicks - 0.25
+	d -= math.Floor(d)
+	e := 0.5 - math.Cos(d*math.Pi)/2
+	return (d*2 + e) / 3
+}
+
+// SkyDarken returns how much sky light is reduced (0-11) by time and weather
+func (t WorldTime) SkyDarken(w Weather) int {
+	d := 1 - w.RainLevel()*5/16
+	e := 1 - w.ThunderLevel()*5/16
+	f := 0.5 + 2*math.Max(-0.25, math.Min(0.25, math.Cos(t.CelestialAngle()*2*math.Pi)))
+	return int((1 - f*d*e) * 11)
+}
+
+// IsDay reports whether it counts as day (undead burn, sky light is high)
+func (t WorldTime) IsDay(w Weather) bool {
+	return t.SkyDarken(w) < 4
+}
+
+// TicksUntilNight returns the ticks until IsDay turns false under the given
+// weather, 0 if it is already night or -1 if the daylight cycle is stopped
+func (t WorldTime) TicksUntilNight(w Weather) int64 {
+	return t.ticksUntil(w, false)
+}
+
+// TicksUntilDay returns the ticks until IsDay turns true under the given
+// weather, 0 if it is already day or -1 if it never will
+func (t WorldTime) TicksUntilDay(w Weather) int64 {
+	return t.ticksUntil(w, true)
+}
+
+// ticksUntil scans one day ahead for the first tick where IsDay equals day
+func (t WorldTime) ticksUntil(w Weather, day bool) int64 {
+	if t.IsDay(w) == day {
+		return 0
+	}
+	if !t.Advancing {
+		return -1
+	}
+	future := t
+	for n := int64(1); n <= DayTicks; n++ {
+		future.DayTime++
+		if future.IsDay(w) == day {
+			return n
+		}
+	}
+	return -1
+}
+
+// floorDiv divides rounding towards negative infinity
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// floorMod returns the modulus with the sign of b
+func floorMod(a, b int64) int64 {
+	return a - floorDiv(a, b)*b
+}
@@ -0,0 +1,62 @@
+package world
+
+// Weather levels above which it counts as raining or thundering
+const (
+	RainThreshold    = 0.2
+	ThunderThreshold = 0.9
+)
+
+// Weather is the rain and thunder state sent by the server. Levels fade between
+// 0 and 1 while the weather changes.
+type Weather struct {
+	Raining bool    // Rain has started (levels may still be fading in)
+	Rain    float64 // Rain level (0-1)
+	Thunder float64 // Thunder level (0-1), only effective while raining
+}
+
+// StartRain handles the begin-raining game event
+func (w *Weather) StartRain() {
+	w.Raining = true
+	w.Rain = 0
+}
+
+// StopRain handles the end-raining game event
+func (w *Weather) StopRain() {
+	w.Raining = false
+	w.Rain = 1
+}
+
+// SetRainLevel handles a rain level change
+func (w *Weather) SetRainLevel(level float64) {
+	w.Rain = clamp01(level)
+}
+
+// SetThunderLevel handles a thunder level change
+func (w *Weather) SetThunderLevel(level float64) {
+	w.Thunder = clamp01(level)
+}
+
+// RainLevel returns the effective rain level
+func (w Weather) RainLevel() float64 {
+	return w.Rain
+}
+
+// ThunderLevel returns the effective thunder level (scaled by rain)
+func (w Weather) ThunderLevel() float64 {
+	return w.Thunder * w.Rain
+}
+
+// IsRaining reports whether it is raining (fishing is faster, fire goes out)
+func (w Weather) IsRaining() bool {
+	return w.RainLevel() > RainThreshold
+}
+
+// IsThundering reports whether there is a thunderstorm (mobs spawn in daylight)
+func (w Weather) IsThundering() bool {
+	return w.ThunderLevel() > ThunderThreshold
+}
+
+// clamp01 limits a level to 0-1
+func clamp01(v float64) float64 {
+	return max(0, min(1, v))
+}
@@ -8,6 +8,7 @@ import (
 var (
 	ErrChunkNotLoaded   = errors.New("chunk not loaded")
 	ErrBlockOutOfBounds = errors.New("block position out of bounds")
+	ErrInvalidClipboard = errors.New("clipboard size does not match its blocks")
 )
 
 // World interface represents a Minecraft world
@@ -18,10 +19,21 @@ type World interface {
 	IsChunkLoaded(x, z int) bool
 }
 
+// BlockChange records a single block modification
+type BlockChange struct {
+	Pos Position // World position of the block
+	Old Block    // Block before the change
+	New Block    // Block after the change
+}
+
+// ChangeListener is called with every batch of block changes applied to a world
+type ChangeListener func(changes []BlockChange)
+
 // SimpleWorld is a basic implementation of the World interface
 type SimpleWorld struct {
-	chunks map[ChunkPos]*Chunk
-	mu     sync.RWMutex
+	chunks    map[ChunkPos]*Chunk
+	listeners []ChangeListener
+	mu        sync.RWMutex
 }
 
 // NewSimpleWorld creates a new simple world implementation
@@ -57,6 +69,10 @@ func (w *SimpleWorld) GetBlock(pos Position) (*Block, error) {
 
 // SetBlock sets a block at the given world position
 func (w *SimpleWorld) SetBlock(pos Position, block *Block) error {
+	if pos.Y < MinWorldY || pos.Y > MaxWorldY {
+		return ErrBlockOutOfBounds
+	}
+
 	chunkX := pos.X >> 4
 	chunkZ := pos.Z >> 4
 
@@ -64,7 +80,6 @@ func (w *SimpleWorld) SetBlock(pos Position, block *Block) error {
 	localZ := pos.Z & 15
 
 	w.mu.Lock()
-	defer w.mu.Unlock()
 
 	chunkPos := ChunkPos{X: chunkX, Z: chunkZ}
 	chunk, exists := w.chunks[chunkPos]
@@ -75,10 +90,34 @@ func (w *SimpleWorld) SetBlock(pos Position, block *Block) error {
 		w.chunks[chunkPos] = chunk
 	}
 
+	var old Block
+	if current := chunk.GetBlock(localX, pos.Y, localZ); current != nil {
+		old = *current
+	}
 	chunk.SetBlock(localX, pos.Y, localZ, *block)
+	listeners := w.listeners
+	w.mu.Unlock()
+
+	if old != *block {
+		notify(listeners, []BlockChange{{Pos: pos, Old: old, New: *block}})
+	}
 	return nil
 }
 
+// OnBlocksChanged registers a listener for block changes.
+// Listeners are called after the world lock is released, once per batch.
+func (w *SimpleWorld) OnBlocksChanged(listener ChangeListener) {
+	w.mu.Lock()
+	w.listeners = append(w.listeners, listener)
+	w.mu.Unlock()
+}
+
+func notify(listeners []ChangeListener, changes []BlockChange) {
+	for _, listener := range listeners {
+		listener(changes)
+	}
+}
+
 // GetChunk gets a chunk at the given chunk coordinates
 func (w *SimpleWorld) GetChunk(x, z int) (*Chunk, error) {
 	w.mu.RLock()